@@ -0,0 +1,161 @@
+package cards
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Card is a single printing of a card in the card database. List fields
+// such as Colors are split out of their comma separated columns.
+//
+// The card database has a row for each face of split, adventure, flip, and
+// double-faced cards. The Repository joins them back into one Card per
+// printing: its fields come from the front face, and Faces holds every face.
+type Card struct {
+	ID     int
+	UUID   string
+	Name   string
+	Layout string
+
+	// FaceName and Side are only set for cards with more than one face.
+	FaceName     string
+	Side         string
+	OtherFaceIDs []string
+	Faces        []Face
+
+	SetCode string
+	Number  string
+	Rarity  string
+
+	// IsPromo and IsOnlineOnly are never the default printing of a card.
+	IsPromo      bool
+	IsOnlineOnly bool
+
+	Colors        []string
+	ColorIdentity []string
+	CMC           float64
+	FaceCMC       *float64
+	ManaCost      string
+
+	// Power, Toughness, and Loyalty are strings because of values like `*`
+	// and `1+*`. They're empty when the card doesn't have them.
+	Power     string
+	Toughness string
+	Loyalty   string
+
+	TypeLine   string
+	Types      []string
+	Subtypes   []string
+	Supertypes []string
+	Keywords   []string
+	Text       string
+	IsTextless bool
+
+	TCGPlayerProductID     int
+	ScryfallID             string
+	ScryfallIllustrationID string
+	ScryfallOracleID       string
+}
+
+// Face is one face of a card with more than one. CMC is the face's own
+// converted mana cost, which differs from the card's for split cards.
+type Face struct {
+	Name     string
+	Side     string
+	ManaCost string
+	CMC      float64
+	Colors   []string
+
+	Power     string
+	Toughness string
+	Loyalty   string
+
+	TypeLine   string
+	Types      []string
+	Subtypes   []string
+	Supertypes []string
+	Keywords   []string
+	Text       string
+}
+
+// faceOf returns the face a card database row holds.
+func faceOf(c Card) Face {
+	f := Face{
+		Name:       c.FaceName,
+		Side:       c.Side,
+		ManaCost:   c.ManaCost,
+		CMC:        c.CMC,
+		Colors:     c.Colors,
+		Power:      c.Power,
+		Toughness:  c.Toughness,
+		Loyalty:    c.Loyalty,
+		TypeLine:   c.TypeLine,
+		Types:      c.Types,
+		Subtypes:   c.Subtypes,
+		Supertypes: c.Supertypes,
+		Keywords:   c.Keywords,
+		Text:       c.Text,
+	}
+	if c.FaceCMC != nil {
+		f.CMC = *c.FaceCMC
+	}
+	return f
+}
+
+// IsMultiFaced returns true if the card has more than one face.
+func (c Card) IsMultiFaced() bool {
+	return len(c.Faces) > 1
+}
+
+// CanTransform returns true if the card's permanent can turn over or flip to
+// show another face. Split and adventure cards pick a face when they're
+// cast, so they can't.
+func (c Card) CanTransform() bool {
+	return c.IsMultiFaced() && CanTransformLayout(c.Layout)
+}
+
+// CanTransformLayout returns true if cards with the MTGJSON layout can turn
+// over or flip to show another face.
+func CanTransformLayout(layout string) bool {
+	switch layout {
+	case "split", "aftermath", "adventure":
+		return false
+	}
+	return true
+}
+
+// NextFace returns the index of the face a card with the MTGJSON layout and
+// number of faces shows after it turns over or flips from the current face,
+// and false if it can't.
+func NextFace(layout string, faces int, current int) (int, bool) {
+	if faces < 2 || !CanTransformLayout(layout) {
+		return 0, false
+	}
+	return (current + 1) % faces, true
+}
+
+// CMCString formats the converted mana cost without a trailing `.0`.
+func (c Card) CMCString() string {
+	return strconv.FormatFloat(c.CMC, 'f', -1, 64)
+}
+
+// HasType returns true if any of the card's types, subtypes, or supertypes
+// match t, ignoring case.
+func (c Card) HasType(t string) bool {
+	for _, list := range [][]string{c.Types, c.Subtypes, c.Supertypes} {
+		for _, have := range list {
+			if strings.EqualFold(have, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitList splits a comma separated list column.
+func splitList(s *string) []string {
+	if s == nil || *s == "" {
+		return []string{}
+	}
+	return strings.Split(*s, ",")
+}
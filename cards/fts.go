@@ -0,0 +1,104 @@
+package cards
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// ftsSchema is the full-text index over card names, type lines, and oracle
+// text. It is an external content table, so it stores only the index and
+// reads the text itself from the cards table.
+//
+// FTS5 is only compiled into go-sqlite3 with the `sqlite_fts5` build tag.
+// Without it the index can't be created and searches fall back to LIKE
+// scans of the cards table.
+const ftsSchema = `
+CREATE VIRTUAL TABLE IF NOT EXISTS "cards_fts" USING fts5(
+	"name", "type", "text",
+	content='cards', content_rowid='id',
+	tokenize='unicode61 remove_diacritics 2'
+);
+`
+
+// ftsWeights ranks name matches above type line matches, and both above
+// oracle text matches.
+const ftsWeights = `10.0, 4.0, 1.0`
+
+// ErrNoFTS is returned by BuildIndex when SQLite was built without FTS5.
+var ErrNoFTS = errs.Class("full-text search unavailable")
+
+// BuildIndex creates the full-text index if needed and rebuilds it from the
+// cards table. Import calls it after every import, so it only needs to be
+// called directly for a card database that was built some other way.
+func BuildIndex(db persistence.Database) error {
+	if _, err := db.Exec(ftsSchema); err != nil {
+		if strings.Contains(err.Error(), "no such module") {
+			return ErrNoFTS.New("rebuild with -tags sqlite_fts5")
+		}
+		return errs.New("failed to create full-text index: %s", err)
+	}
+	if _, err := db.Exec(`INSERT INTO "cards_fts"("cards_fts") VALUES('rebuild')`); err != nil {
+		return errs.New("failed to rebuild full-text index: %s", err)
+	}
+	return nil
+}
+
+// ftsIndex remembers whether the card database has a usable full-text index.
+type ftsIndex struct {
+	once sync.Once
+	ok   bool
+}
+
+// hasFTS returns true if searches can use the full-text index.
+func (r *Repository) hasFTS() bool {
+	r.fts.once.Do(func() {
+		rows, err := r.db.Query(`SELECT 1 FROM "cards_fts" LIMIT 0`)
+		if err != nil {
+			return
+		}
+		r.fts.ok = rows.Close() == nil
+	})
+	return r.fts.ok
+}
+
+// matchPrefixes builds an FTS5 query that matches every word of s as a word
+// prefix in column, or in any indexed column if column is empty. It returns
+// "" if s has no words.
+func matchPrefixes(column, s string) string {
+	terms := []string{}
+	for _, w := range words(s) {
+		terms = append(terms, ftsColumn(column)+`"`+w+`"*`)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// matchPhrase builds an FTS5 query that matches the words of s in order in
+// column, with the last word matched as a prefix. It returns "" if s has no
+// words.
+func matchPhrase(column, s string) string {
+	w := words(s)
+	if len(w) == 0 {
+		return ""
+	}
+	return ftsColumn(column) + `"` + strings.Join(w, " ") + `"*`
+}
+
+func ftsColumn(column string) string {
+	if column == "" {
+		return ""
+	}
+	return column + " : "
+}
+
+// words splits s the same way the unicode61 tokenizer does, so that the
+// words can be quoted in an FTS5 query without escaping.
+func words(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
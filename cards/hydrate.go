@@ -0,0 +1,208 @@
+package cards
+
+import (
+	"strings"
+)
+
+// DeckEntry is one line of a decklist: a quantity of a named card, and
+// optionally the printing to use.
+type DeckEntry struct {
+	Quantity int
+	Name     string
+	Printing Printing
+}
+
+// HydratedEntry is a DeckEntry matched to the card database.
+type HydratedEntry struct {
+	DeckEntry
+
+	// Resolution is how the name was matched. Its Printings are every
+	// printing of the card.
+	Resolution Resolution
+	// Card is the selected printing, or the default printing if the entry
+	// didn't pick one. It's the zero Card if the entry couldn't be hydrated.
+	Card Card
+	// Err is set when the name resolved but the selected printing doesn't
+	// exist.
+	Err error
+}
+
+// Hydrated returns true if the entry was matched to a printing.
+func (h HydratedEntry) Hydrated() bool {
+	return h.Resolution.Resolved() && h.Err == nil
+}
+
+// Hydrate matches every entry of a decklist to a card printing in one
+// query, plus one more if any names need fuzzy matching. Entries come back
+// in the same order with their quantities, and entries that couldn't be
+// matched are returned with their suggestions rather than dropped. Names are
+// matched the same way as Resolve.
+func (r *Repository) Hydrate(entries []DeckEntry) ([]HydratedEntry, error) {
+	out := make([]HydratedEntry, len(entries))
+	names := []string{}
+	for i, e := range entries {
+		out[i] = HydratedEntry{
+			DeckEntry:  e,
+			Resolution: Resolution{Query: e.Name, Match: MatchNone, Suggestions: []string{}},
+		}
+		if name := normalizeName(e.Name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	found, err := r.printingsByName(names)
+	if err != nil {
+		return nil, err
+	}
+
+	// names that aren't exact card or face names go through the name
+	// index, and the cards it finds are looked up together
+	missingNames := []string{}
+	for i, e := range entries {
+		name := normalizeName(e.Name)
+		if name == "" {
+			continue
+		}
+		if card, ok := found[name]; ok {
+			match := MatchExact
+			if card.name != name {
+				match = MatchFace
+			}
+			out[i].Resolution.Match = match
+			out[i].Resolution.Name = card.name
+			continue
+		}
+
+		idx, err := r.index()
+		if err != nil {
+			return nil, err
+		}
+		match, name, suggestions := idx.match(name)
+		out[i].Resolution.Suggestions = suggestions
+		if match == MatchNone {
+			continue
+		}
+		out[i].Resolution.Match = match
+		out[i].Resolution.Name = name
+		missingNames = append(missingNames, name)
+	}
+	if len(missingNames) > 0 {
+		more, err := r.printingsByName(missingNames)
+		if err != nil {
+			return nil, err
+		}
+		for name, card := range more {
+			found[name] = card
+		}
+	}
+
+	for i := range out {
+		res := &out[i].Resolution
+		if !res.Resolved() {
+			continue
+		}
+		card, ok := found[res.Name]
+		if !ok {
+			// the name index and the card database disagree
+			res.Match, res.Name = MatchNone, ""
+			continue
+		}
+		res.Printings = card.printings
+		out[i].Card, out[i].Err = selectPrinting(card.name, card.printings, out[i].Printing)
+	}
+	return out, nil
+}
+
+// namedPrintings holds every printing of a card found by one of its names.
+type namedPrintings struct {
+	name      string
+	printings []Card
+}
+
+// printingsByName looks up every printing of the named cards, with the
+// default printing first, keyed by the names that found them. A name can
+// be a card name or a face name.
+func (r *Repository) printingsByName(names []string) (map[string]namedPrintings, error) {
+	out := map[string]namedPrintings{}
+	if len(names) == 0 {
+		return out, nil
+	}
+	printings, err := r.ByNames(uniqueStrings(names))
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*namedPrintings{}
+	for _, c := range printings {
+		group, ok := byName[c.Name]
+		if !ok {
+			group = &namedPrintings{name: c.Name}
+			byName[c.Name] = group
+		}
+		group.printings = append(group.printings, c)
+	}
+	for _, group := range byName {
+		out[group.name] = *group
+		for _, f := range group.printings[0].Faces {
+			out[f.Name] = *group
+		}
+	}
+	return out, nil
+}
+
+// selectPrinting picks the printing p selects from every printing of a
+// card, or the default printing if p is zero.
+func selectPrinting(name string, printings []Card, p Printing) (Card, error) {
+	if p.IsZero() {
+		return printings[0], nil
+	}
+	if p.Number != "" && p.SetCode == "" {
+		return Card{}, ErrPrintingNotFound.New("collector number %s needs a set code", p.Number)
+	}
+	for _, c := range printings {
+		if p.UUID != "" {
+			if strings.EqualFold(c.UUID, p.UUID) || containsFold(c.OtherFaceIDs, p.UUID) {
+				return c, nil
+			}
+			continue
+		}
+		if !strings.EqualFold(c.SetCode, p.SetCode) {
+			continue
+		}
+		if p.Number == "" || strings.EqualFold(c.Number, p.Number) || numberOfFace(c, p.Number) {
+			return c, nil
+		}
+	}
+	return Card{}, ErrPrintingNotFound.New("%s has no printing %s", name, p)
+}
+
+// numberOfFace returns true if number is the collector number of one of the
+// card's other faces, like "51b" for the back of a double-faced card.
+func numberOfFace(c Card, number string) bool {
+	if len(c.Faces) < 2 {
+		return false
+	}
+	base := strings.TrimRight(c.Number, "abcdefghijklmnopqrstuvwxyz")
+	return base != c.Number && strings.EqualFold(strings.TrimRight(strings.ToLower(number), "abcdefghijklmnopqrstuvwxyz"), base)
+}
+
+func containsFold(list []string, s string) bool {
+	for _, have := range list {
+		if strings.EqualFold(have, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueStrings(list []string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, s := range list {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
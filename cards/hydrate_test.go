@@ -0,0 +1,105 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHydrate(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	entries := []DeckEntry{
+		{Quantity: 1, Name: "Teysa Karlov"},
+		{Quantity: 30, Name: "Swamp"},
+		{Quantity: 2, Name: "Swamp", Printing: Printing{SetCode: "LEA", Number: "278"}},
+		{Quantity: 1, Name: "Lim-Dul's Vault", Printing: Printing{SetCode: "me2"}},
+		{Quantity: 1, Name: "Stomp"},
+		{Quantity: 1, Name: "Insectile Aberration", Printing: Printing{SetCode: "ISD", Number: "51b"}},
+		{Quantity: 1, Name: "Expansion/Explosion", Printing: Printing{UUID: "0a0b1c2d-0000-4000-8000-000000000003"}},
+		{Quantity: 1, Name: "Sera Angel"},
+		{Quantity: 1, Name: "Sera Angle"},
+		{Quantity: 1, Name: "Teysa Karlov", Printing: Printing{SetCode: "LEA"}},
+		{Quantity: 1, Name: ""},
+	}
+	hydrated, err := repo.Hydrate(entries)
+	assert.NoError(t, err)
+	assert.Len(t, hydrated, len(entries))
+
+	want := []struct {
+		match MatchKind
+		name  string
+		set   string
+	}{
+		{MatchExact, "Teysa Karlov", "RNA"},
+		{MatchExact, "Swamp", "RNA"},
+		{MatchExact, "Swamp", "LEA"},
+		{MatchAccent, "Lim-Dûl's Vault", "ME2"},
+		{MatchFace, "Bonecrusher Giant // Stomp", "ELD"},
+		{MatchFace, "Delver of Secrets // Insectile Aberration", "ISD"},
+		{MatchExact, "Expansion // Explosion", "GRN"},
+		{MatchFuzzy, "Serra Angel", "LEA"},
+		{MatchNone, "", ""},
+		{MatchExact, "Teysa Karlov", ""},
+		{MatchNone, "", ""},
+	}
+	for i, w := range want {
+		h := hydrated[i]
+		assert.Equal(t, entries[i], h.DeckEntry, i)
+		assert.Equal(t, w.match, h.Resolution.Match, i)
+		assert.Equal(t, w.name, h.Resolution.Name, i)
+		assert.Equal(t, w.set, h.Card.SetCode, i)
+	}
+
+	assert.True(t, hydrated[0].Hydrated())
+	assert.Len(t, hydrated[1].Resolution.Printings, 2)
+	assert.Equal(t, "278", hydrated[2].Card.Number)
+	assert.Equal(t, []string{"Serra Angel"}, hydrated[8].Resolution.Suggestions)
+	assert.False(t, hydrated[8].Hydrated())
+	assert.False(t, hydrated[9].Hydrated())
+	assert.True(t, ErrPrintingNotFound.Has(hydrated[9].Err))
+	assert.EqualError(t, hydrated[9].Err, "printing not found: Teysa Karlov has no printing LEA")
+
+	t.Run("test empty decklist", func(t *testing.T) {
+		hydrated, err := repo.Hydrate(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, hydrated)
+	})
+}
+
+// BenchmarkHydrate compares hydrating a 100 card decklist in a batch to
+// resolving it a line at a time.
+func BenchmarkHydrate(b *testing.B) {
+	db, cleanup := newTestDB(b)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	names := []string{
+		"Teysa Karlov", "Serra Angel", "Delver of Secrets", "Lim-Dûl's Vault",
+		"Expansion // Explosion", "Bonecrusher Giant", "Bushi Tenderfoot",
+	}
+	entries := []DeckEntry{{Quantity: 1, Name: "Teysa Karlov"}}
+	for i := 0; len(entries) < 70; i++ {
+		entries = append(entries, DeckEntry{Quantity: 1, Name: names[i%len(names)]})
+	}
+	entries = append(entries, DeckEntry{Quantity: 30, Name: "Swamp"})
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.Hydrate(entries); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("per line", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, e := range entries {
+				if _, err := repo.Resolve(e.Name); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,411 @@
+package cards
+
+import (
+	"archive/zip"
+	"bufio"
+	"compress/bzip2"
+	"compress/gzip"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// ImportStats reports what an Import did.
+type ImportStats struct {
+	Sets   int
+	Cards  int
+	Tokens int
+}
+
+// mtgjsonSet is the part of an MTGJSON set that gets imported.
+type mtgjsonSet struct {
+	Code        string        `json:"code"`
+	Name        string        `json:"name"`
+	ReleaseDate string        `json:"releaseDate"`
+	Cards       []mtgjsonCard `json:"cards"`
+	Tokens      []mtgjsonCard `json:"tokens"`
+}
+
+// mtgjsonCard is an MTGJSON card. Both the v4 and v5 layouts are accepted:
+// v5 moved third-party IDs under `identifiers` and renamed
+// convertedManaCost to manaValue.
+type mtgjsonCard struct {
+	UUID                  string   `json:"uuid"`
+	Name                  string   `json:"name"`
+	ASCIIName             *string  `json:"asciiName"`
+	FaceName              *string  `json:"faceName"`
+	Side                  *string  `json:"side"`
+	Layout                string   `json:"layout"`
+	OtherFaceIDs          []string `json:"otherFaceIds"`
+	SetCode               string   `json:"setCode"`
+	Number                string   `json:"number"`
+	Rarity                string   `json:"rarity"`
+	Colors                []string `json:"colors"`
+	ColorIdentity         []string `json:"colorIdentity"`
+	ConvertedManaCost     *float64 `json:"convertedManaCost"`
+	ManaValue             *float64 `json:"manaValue"`
+	FaceConvertedManaCost *float64 `json:"faceConvertedManaCost"`
+	FaceManaValue         *float64 `json:"faceManaValue"`
+	ManaCost              string   `json:"manaCost"`
+	Power                 *string  `json:"power"`
+	Toughness             *string  `json:"toughness"`
+	Loyalty               *string  `json:"loyalty"`
+	Type                  string   `json:"type"`
+	Types                 []string `json:"types"`
+	Subtypes              []string `json:"subtypes"`
+	Supertypes            []string `json:"supertypes"`
+	Keywords              []string `json:"keywords"`
+	Text                  string   `json:"text"`
+	IsTextless            bool     `json:"isTextless"`
+	IsPromo               bool     `json:"isPromo"`
+	IsOnlineOnly          bool     `json:"isOnlineOnly"`
+
+	Identifiers mtgjsonIdentifiers `json:"identifiers"`
+
+	// v4 identifiers
+	TCGPlayerProductID     json.Number `json:"tcgplayerProductId"`
+	ScryfallID             string      `json:"scryfallId"`
+	ScryfallIllustrationID string      `json:"scryfallIllustrationId"`
+	ScryfallOracleID       string      `json:"scryfallOracleId"`
+}
+
+type mtgjsonIdentifiers struct {
+	TCGPlayerProductID     json.Number `json:"tcgplayerProductId"`
+	ScryfallID             string      `json:"scryfallId"`
+	ScryfallIllustrationID string      `json:"scryfallIllustrationId"`
+	ScryfallOracleID       string      `json:"scryfallOracleId"`
+}
+
+const insertCard = `INSERT INTO "cards" ("uuid", "name", "asciiName", "faceName",
+	"side", "layout", "otherFaceIds", "setCode", "number", "rarity", "colors",
+	"colorIdentity", "convertedManaCost", "faceConvertedManaCost", "manaCost",
+	"power", "toughness", "loyalty", "type", "types", "subtypes", "supertypes",
+	"keywords", "text", "isTextless", "tcgplayerProductId", "scryfallId",
+	"scryfallIllustrationId", "scryfallOracleId", "isPromo", "isOnlineOnly")
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT ("uuid") DO UPDATE SET "name" = excluded."name",
+	"asciiName" = excluded."asciiName", "faceName" = excluded."faceName",
+	"side" = excluded."side", "layout" = excluded."layout",
+	"otherFaceIds" = excluded."otherFaceIds", "setCode" = excluded."setCode",
+	"number" = excluded."number", "rarity" = excluded."rarity",
+	"colors" = excluded."colors", "colorIdentity" = excluded."colorIdentity",
+	"convertedManaCost" = excluded."convertedManaCost",
+	"faceConvertedManaCost" = excluded."faceConvertedManaCost",
+	"manaCost" = excluded."manaCost", "power" = excluded."power",
+	"toughness" = excluded."toughness", "loyalty" = excluded."loyalty",
+	"type" = excluded."type", "types" = excluded."types",
+	"subtypes" = excluded."subtypes", "supertypes" = excluded."supertypes",
+	"keywords" = excluded."keywords", "text" = excluded."text",
+	"isTextless" = excluded."isTextless",
+	"tcgplayerProductId" = excluded."tcgplayerProductId",
+	"scryfallId" = excluded."scryfallId",
+	"scryfallIllustrationId" = excluded."scryfallIllustrationId",
+	"scryfallOracleId" = excluded."scryfallOracleId",
+	"isPromo" = excluded."isPromo", "isOnlineOnly" = excluded."isOnlineOnly"`
+
+// insertToken is insertCard for the tokens table, which has the same
+// columns.
+var insertToken = strings.Replace(insertCard, `INTO "cards"`, `INTO "tokens"`, 1)
+
+const insertSet = `INSERT INTO "sets" ("code", "name", "releaseDate") VALUES (?, ?, ?)
+	ON CONFLICT ("code") DO UPDATE SET "name" = excluded."name",
+	"releaseDate" = excluded."releaseDate"`
+
+// OpenImportFile opens an MTGJSON file for Import, transparently
+// decompressing .gz, .bz2, and .zip files. A .zip must contain a single JSON
+// file.
+func OpenImportFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(bufio.NewReader(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, errs.New("failed to open gzip file: %s", err)
+		}
+		return readCloser{Reader: gz, closers: []io.Closer{gz, f}}, nil
+	case strings.HasSuffix(path, ".bz2"):
+		return readCloser{Reader: bzip2.NewReader(bufio.NewReader(f)), closers: []io.Closer{f}}, nil
+	case strings.HasSuffix(path, ".zip"):
+		_ = f.Close()
+		z, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, errs.New("failed to open zip file: %s", err)
+		}
+		if len(z.File) != 1 {
+			_ = z.Close()
+			return nil, errs.New("zip file must contain exactly one file; had %d", len(z.File))
+		}
+		r, err := z.File[0].Open()
+		if err != nil {
+			_ = z.Close()
+			return nil, errs.Wrap(err)
+		}
+		return readCloser{Reader: r, closers: []io.Closer{r, z}}, nil
+	default:
+		return f, nil
+	}
+}
+
+// readCloser closes every layer of a decompressing reader.
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r readCloser) Close() error {
+	var group errs.Group
+	for _, c := range r.closers {
+		group.Add(c.Close())
+	}
+	return group.Err()
+}
+
+// Import reads an MTGJSON AllPrintings (or single set) JSON document from r
+// and loads every card into the cards table of db, and every token into the
+// tokens table, creating the tables and their indexes if needed. Cards and
+// tokens are keyed by their MTGJSON uuid, so importing a newer file updates
+// existing printings in place and adds new sets without changing the IDs of
+// cards that were already there.
+//
+// The document is streamed one set at a time, so the full AllPrintings file
+// never has to fit in memory. The full-text index is rebuilt once every card
+// is in.
+func Import(db *persistence.DB, r io.Reader) (ImportStats, error) {
+	stats := ImportStats{}
+
+	if err := EnsureSchema(db); err != nil {
+		return stats, err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return stats, errs.Wrap(err)
+	}
+	stmt, err := tx.Prepare(insertCard)
+	if err != nil {
+		_ = tx.Rollback()
+		return stats, errs.Wrap(err)
+	}
+	setStmt, err := tx.Prepare(insertSet)
+	if err != nil {
+		_ = stmt.Close()
+		_ = tx.Rollback()
+		return stats, errs.Wrap(err)
+	}
+	tokenStmt, err := tx.Prepare(insertToken)
+	if err != nil {
+		_ = stmt.Close()
+		_ = setStmt.Close()
+		_ = tx.Rollback()
+		return stats, errs.Wrap(err)
+	}
+
+	err = decodeSets(r, func(set mtgjsonSet) error {
+		if _, err := setStmt.Exec(set.Code, nullString(set.Name), nullString(set.ReleaseDate)); err != nil {
+			return errs.New("failed to import set %s: %s", set.Code, err)
+		}
+		for _, c := range set.Cards {
+			if c.SetCode == "" {
+				c.SetCode = set.Code
+			}
+			if err := insert(stmt, c); err != nil {
+				return errs.New("failed to import %s (%s): %s", c.Name, c.UUID, err)
+			}
+			stats.Cards++
+		}
+		for _, c := range set.Tokens {
+			if c.SetCode == "" {
+				c.SetCode = set.Code
+			}
+			if err := insert(tokenStmt, c); err != nil {
+				return errs.New("failed to import token %s (%s): %s", c.Name, c.UUID, err)
+			}
+			stats.Tokens++
+		}
+		stats.Sets++
+		log.Printf("imported set %s: %d cards, %d tokens", set.Code, len(set.Cards), len(set.Tokens))
+		return nil
+	})
+	_ = stmt.Close()
+	_ = setStmt.Close()
+	_ = tokenStmt.Close()
+	if err != nil {
+		_ = tx.Rollback()
+		return stats, err
+	}
+	if err := tx.Commit(); err != nil {
+		return stats, errs.Wrap(err)
+	}
+
+	if err := BuildIndex(db); err != nil {
+		if !ErrNoFTS.Has(err) {
+			return stats, err
+		}
+		log.Printf("skipping full-text index: %s", err)
+	}
+	return stats, nil
+}
+
+// decodeSets walks the MTGJSON document in r and calls fn for each set. It
+// accepts AllPrintings, where `data` maps set codes to sets, as well as a
+// single set file, where `data` is the set itself.
+func decodeSets(r io.Reader, fn func(mtgjsonSet) error) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if key != "data" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return errs.Wrap(err)
+			}
+			continue
+		}
+
+		return decodeData(dec, fn)
+	}
+
+	return errs.New("no data found in MTGJSON file")
+}
+
+// decodeData decodes the `data` object of an MTGJSON file one set at a time.
+// In a single set file the keys of `data` are the set's own fields instead.
+func decodeData(dec *json.Decoder, fn func(mtgjsonSet) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	single := mtgjsonSet{}
+	isSingle := false
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		switch key {
+		case "code":
+			err = dec.Decode(&single.Code)
+		case "name":
+			err = dec.Decode(&single.Name)
+		case "releaseDate":
+			err = dec.Decode(&single.ReleaseDate)
+		case "cards":
+			isSingle = true
+			err = dec.Decode(&single.Cards)
+		case "tokens":
+			isSingle = true
+			err = dec.Decode(&single.Tokens)
+		default:
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return errs.New("failed to read %v: %s", key, err)
+			}
+			if isSingle || len(raw) == 0 || raw[0] != '{' {
+				continue
+			}
+			var set mtgjsonSet
+			if json.Unmarshal(raw, &set) != nil || (set.Code == "" && set.Cards == nil) {
+				continue
+			}
+			err = fn(set)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if isSingle {
+		return fn(single)
+	}
+	return nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return errs.New("malformed MTGJSON file: expected %q, got %v", want, tok)
+	}
+	return nil
+}
+
+func insert(stmt *sql.Stmt, c mtgjsonCard) error {
+	ids := c.Identifiers
+	if ids.ScryfallID == "" {
+		ids = mtgjsonIdentifiers{
+			TCGPlayerProductID:     c.TCGPlayerProductID,
+			ScryfallID:             c.ScryfallID,
+			ScryfallIllustrationID: c.ScryfallIllustrationID,
+			ScryfallOracleID:       c.ScryfallOracleID,
+		}
+	}
+
+	cmc := c.ManaValue
+	if cmc == nil {
+		cmc = c.ConvertedManaCost
+	}
+	faceCMC := c.FaceManaValue
+	if faceCMC == nil {
+		faceCMC = c.FaceConvertedManaCost
+	}
+
+	var tcgplayerID *int64
+	if id, err := strconv.ParseInt(string(ids.TCGPlayerProductID), 10, 64); err == nil {
+		tcgplayerID = &id
+	}
+
+	_, err := stmt.Exec(c.UUID, c.Name, c.ASCIIName, c.FaceName, c.Side,
+		c.Layout, joinList(c.OtherFaceIDs), c.SetCode, c.Number, c.Rarity,
+		joinList(c.Colors), joinList(c.ColorIdentity), cmc, faceCMC,
+		c.ManaCost, c.Power, c.Toughness, c.Loyalty, c.Type,
+		joinList(c.Types), joinList(c.Subtypes), joinList(c.Supertypes),
+		joinList(c.Keywords), c.Text, boolInt(c.IsTextless), tcgplayerID,
+		nullString(ids.ScryfallID), nullString(ids.ScryfallIllustrationID),
+		nullString(ids.ScryfallOracleID), boolInt(c.IsPromo),
+		boolInt(c.IsOnlineOnly))
+	return err
+}
+
+// boolInt stores a bool the way MTGJSON's SQLite files do.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// joinList stores MTGJSON list fields the same way MTGJSON's own SQLite
+// export does.
+func joinList(list []string) string {
+	return strings.Join(list, ",")
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
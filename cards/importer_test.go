@@ -0,0 +1,123 @@
+package cards
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+func TestImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edhgo-cards")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := persistence.NewSQLite(filepath.Join(dir, "cards.sqlite"))
+	assert.NoError(t, err)
+
+	f, err := OpenImportFile("testdata/AllPrintings.json")
+	assert.NoError(t, err)
+	stats, err := Import(db, f)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.Equal(t, ImportStats{Sets: 4, Cards: 15, Tokens: 2}, stats)
+
+	t.Run("test card columns", func(t *testing.T) {
+		rows, err := db.Query(`SELECT "name", "colors", "convertedManaCost",
+			"manaCost", "power", "types", "supertypes", "isTextless",
+			"tcgplayerProductId", "scryfallIllustrationId"
+			FROM "cards" WHERE "name" = ?`, "Teysa Karlov")
+		assert.NoError(t, err)
+		defer rows.Close()
+
+		assert.True(t, rows.Next())
+		var (
+			name, colors, cmc, manaCost, power, types, supertypes string
+			textless, tcgplayerID                                 int
+			illustrationID                                        *string
+		)
+		assert.NoError(t, rows.Scan(&name, &colors, &cmc, &manaCost, &power,
+			&types, &supertypes, &textless, &tcgplayerID, &illustrationID))
+		assert.Equal(t, "B,W", colors)
+		assert.Equal(t, "4", cmc)
+		assert.Equal(t, "{2}{W}{B}", manaCost)
+		assert.Equal(t, "2", power)
+		assert.Equal(t, "Creature", types)
+		assert.Equal(t, "Legendary", supertypes)
+		assert.Equal(t, 180769, tcgplayerID)
+		assert.Equal(t, "illus-teysa", *illustrationID)
+		assert.False(t, rows.Next())
+	})
+
+	t.Run("test v4 identifiers and set code fallback", func(t *testing.T) {
+		var setCode, scryfallID string
+		var tcgplayerID int
+		rows, err := db.Query(`SELECT "setCode", "scryfallId", "tcgplayerProductId" FROM "cards" WHERE "uuid" = '0a0b1c2d-0000-4000-8000-000000000004'`)
+		assert.NoError(t, err)
+		defer rows.Close()
+		assert.True(t, rows.Next())
+		assert.NoError(t, rows.Scan(&setCode, &scryfallID, &tcgplayerID))
+		assert.Equal(t, "LEA", setCode)
+		assert.Equal(t, "scry-swamp", scryfallID)
+		assert.Equal(t, 1234, tcgplayerID)
+	})
+
+	t.Run("test reimport keeps ids", func(t *testing.T) {
+		before := cardID(t, db, "Swamp")
+
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		raw, err := ioutil.ReadFile("testdata/AllPrintings.json")
+		assert.NoError(t, err)
+		_, err = gz.Write(raw)
+		assert.NoError(t, err)
+		assert.NoError(t, gz.Close())
+		path := filepath.Join(dir, "AllPrintings.json.gz")
+		assert.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+
+		f, err := OpenImportFile(path)
+		assert.NoError(t, err)
+		defer f.Close()
+		stats, err := Import(db, f)
+		assert.NoError(t, err)
+		assert.Equal(t, 15, stats.Cards)
+		assert.Equal(t, before, cardID(t, db, "Swamp"))
+	})
+
+	t.Run("test single set file", func(t *testing.T) {
+		set := `{"meta": {}, "data": {"code": "TST", "name": "Test", "cards": [
+			{"uuid": "0a0b1c2d-0000-4000-8000-000000000005", "name": "Shock",
+			 "colors": ["R"], "colorIdentity": ["R"], "manaValue": 1,
+			 "manaCost": "{R}", "type": "Instant", "types": ["Instant"]}
+		]}}`
+		stats, err := Import(db, bytes.NewBufferString(set))
+		assert.NoError(t, err)
+		assert.Equal(t, ImportStats{Sets: 1, Cards: 1}, stats)
+		assert.NotZero(t, cardID(t, db, "Shock"))
+	})
+
+	t.Run("test malformed file", func(t *testing.T) {
+		_, err := Import(db, bytes.NewBufferString(`{"meta": {}}`))
+		assert.Error(t, err)
+		_, err = Import(db, bytes.NewBufferString(`[]`))
+		assert.Error(t, err)
+	})
+}
+
+func cardID(t *testing.T, db persistence.Database, name string) int {
+	rows, err := db.Query(`SELECT "id" FROM "cards" WHERE "name" = ?`, name)
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var id int
+	if rows.Next() {
+		assert.NoError(t, rows.Scan(&id))
+	}
+	return id
+}
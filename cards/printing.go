@@ -0,0 +1,126 @@
+package cards
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrPrintingNotFound is returned when a selected printing doesn't exist.
+var ErrPrintingNotFound = errs.Class("printing not found")
+
+// uuidPattern matches an MTGJSON uuid.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Printing selects a printing of a card, either by its MTGJSON uuid or by
+// its set code and collector number. The zero Printing selects the default
+// printing, and a SetCode without a Number selects the default printing
+// within that set.
+type Printing struct {
+	UUID    string
+	SetCode string
+	Number  string
+}
+
+// IsZero returns true if the Printing doesn't select anything.
+func (p Printing) IsZero() bool {
+	return p.UUID == "" && p.SetCode == "" && p.Number == ""
+}
+
+// String formats the Printing the way decklists usually write it, e.g.
+// "LEA #278".
+func (p Printing) String() string {
+	switch {
+	case p.UUID != "":
+		return p.UUID
+	case p.Number != "":
+		return fmt.Sprintf("%s #%s", strings.ToUpper(p.SetCode), p.Number)
+	default:
+		return strings.ToUpper(p.SetCode)
+	}
+}
+
+// ParsePrinting reads a Printing from decklist columns: either a single
+// uuid, or a set code followed by an optional collector number. Blank
+// fields are ignored.
+func ParsePrinting(fields ...string) Printing {
+	trimmed := []string{}
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			trimmed = append(trimmed, f)
+		}
+	}
+	switch {
+	case len(trimmed) == 0:
+		return Printing{}
+	case uuidPattern.MatchString(trimmed[0]):
+		return Printing{UUID: strings.ToLower(trimmed[0])}
+	case len(trimmed) == 1:
+		return Printing{SetCode: strings.Trim(trimmed[0], "()[]")}
+	default:
+		return Printing{
+			SetCode: strings.Trim(trimmed[0], "()[]"),
+			Number:  strings.TrimPrefix(trimmed[1], "#"),
+		}
+	}
+}
+
+// Printing returns the selected printing of the named card. An empty name
+// matches any card, which is only useful with a uuid or a set code and
+// number. Like ByName, the name can be a full name or a face name.
+func (r *Repository) Printing(name string, p Printing) (Card, error) {
+	if name == "" && p.UUID == "" && (p.SetCode == "" || p.Number == "") {
+		return Card{}, errs.New("must provide name for card")
+	}
+	if p.Number != "" && p.SetCode == "" {
+		return Card{}, errs.New("collector number %s needs a set code", p.Number)
+	}
+
+	var (
+		where []string
+		args  []interface{}
+	)
+	if name != "" {
+		where = append(where, `("name" = ? OR "faceName" = ?)`)
+		args = append(args, name, name)
+	}
+	if p.UUID != "" {
+		where = append(where, `"uuid" = ?`)
+		args = append(args, p.UUID)
+	}
+	if p.SetCode != "" {
+		where = append(where, `"setCode" = ? COLLATE NOCASE`)
+		args = append(args, p.SetCode)
+	}
+	if p.Number != "" {
+		where = append(where, `"number" = ? COLLATE NOCASE`)
+		args = append(args, p.Number)
+	}
+
+	found, err := r.query(`SELECT `+columns+` FROM "cards" WHERE `+
+		strings.Join(where, " AND ")+defaultOrder, args...)
+	if err != nil {
+		return Card{}, err
+	}
+	if len(found) == 0 {
+		if p.IsZero() {
+			return Card{}, ErrPrintingNotFound.New("no card named %q", name)
+		}
+		if name == "" {
+			return Card{}, ErrPrintingNotFound.New("no printing %s", p)
+		}
+		return Card{}, ErrPrintingNotFound.New("%s has no printing %s", name, p)
+	}
+	return found[0], nil
+}
+
+// ByUUID returns the printing with the given MTGJSON uuid. The uuid of any
+// face of a multi-faced card returns the whole card.
+func (r *Repository) ByUUID(uuid string) (Card, error) {
+	if uuid == "" {
+		return Card{}, errs.New("must provide uuid for card")
+	}
+	return r.Printing("", Printing{UUID: uuid})
+}
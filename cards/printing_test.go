@@ -0,0 +1,72 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrinting(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	cases := []struct {
+		name     string
+		card     string
+		printing Printing
+		set      string
+		number   string
+	}{
+		{"default", "Swamp", Printing{}, "RNA", "262"},
+		{"set and number", "Swamp", Printing{SetCode: "lea", Number: "278"}, "LEA", "278"},
+		{"set only", "Lim-Dûl's Vault", Printing{SetCode: "ME2"}, "ME2", "202"},
+		{"uuid", "Swamp", Printing{UUID: "0a0b1c2d-0000-4000-8000-000000000004"}, "LEA", "278"},
+		{"uuid without name", "", Printing{UUID: "0a0b1c2d-0000-4000-8000-000000000014"}, "RNA", "262"},
+		{"set and number without name", "", Printing{SetCode: "PRM", Number: "31"}, "PRM", "31"},
+		{"face name", "Insectile Aberration", Printing{SetCode: "ISD", Number: "51b"}, "ISD", "51a"},
+		{"back face uuid", "", Printing{UUID: "0a0b1c2d-0000-4000-8000-000000000003"}, "GRN", "224"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			card, err := repo.Printing(c.card, c.printing)
+			assert.NoError(t, err)
+			assert.Equal(t, c.set, card.SetCode)
+			assert.Equal(t, c.number, card.Number)
+		})
+	}
+
+	t.Run("test missing printings", func(t *testing.T) {
+		_, err := repo.Printing("Swamp", Printing{SetCode: "LEA", Number: "999"})
+		assert.True(t, ErrPrintingNotFound.Has(err))
+		assert.EqualError(t, err, "printing not found: Swamp has no printing LEA #999")
+
+		_, err = repo.Printing("Teysa Karlov", Printing{UUID: "0a0b1c2d-0000-4000-8000-000000000004"})
+		assert.True(t, ErrPrintingNotFound.Has(err))
+
+		_, err = repo.Printing("Black Lotus", Printing{})
+		assert.True(t, ErrPrintingNotFound.Has(err))
+
+		_, err = repo.Printing("Swamp", Printing{Number: "278"})
+		assert.Error(t, err)
+		_, err = repo.Printing("", Printing{SetCode: "LEA"})
+		assert.Error(t, err)
+	})
+
+	t.Run("test by uuid", func(t *testing.T) {
+		card, err := repo.ByUUID("0a0b1c2d-0000-4000-8000-000000000008")
+		assert.NoError(t, err)
+		assert.Equal(t, "Delver of Secrets // Insectile Aberration", card.Name)
+		assert.Len(t, card.Faces, 2)
+	})
+}
+
+func TestParsePrinting(t *testing.T) {
+	assert.Equal(t, Printing{}, ParsePrinting())
+	assert.Equal(t, Printing{}, ParsePrinting(" ", ""))
+	assert.Equal(t, Printing{SetCode: "LEA"}, ParsePrinting("LEA"))
+	assert.Equal(t, Printing{SetCode: "LEA", Number: "278"}, ParsePrinting("(LEA)", "#278"))
+	assert.Equal(t, Printing{UUID: "0a0b1c2d-0000-4000-8000-00000000000a"},
+		ParsePrinting("0A0B1C2D-0000-4000-8000-00000000000A"))
+	assert.Equal(t, "LEA #278", Printing{SetCode: "lea", Number: "278"}.String())
+}
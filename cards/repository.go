@@ -0,0 +1,268 @@
+package cards
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// columns is every column a Card is scanned from, in scan order.
+const columns = `"id", "uuid", "name", "layout", "faceName", "side",
+	"otherFaceIds", "setCode", "number", "rarity", "colors", "colorIdentity",
+	"convertedManaCost", "faceConvertedManaCost", "manaCost", "power",
+	"toughness", "loyalty", "type", "types", "subtypes", "supertypes",
+	"keywords", "text", "isTextless", "tcgplayerProductId", "scryfallId",
+	"scryfallIllustrationId", "scryfallOracleId", "isPromo", "isOnlineOnly"`
+
+// defaultOrder orders the printings of a card with the default printing
+// first: paper before online only, regular before promo, then the newest.
+const defaultOrder = ` ORDER BY COALESCE("isOnlineOnly", 0), COALESCE("isPromo", 0),
+	(SELECT "releaseDate" FROM "sets" WHERE "sets"."code" = "cards"."setCode") DESC, "id"`
+
+// Repository looks up cards in the card database. It is the only place that
+// knows the shape of the cards table.
+type Repository struct {
+	db persistence.Database
+
+	names nameIndex
+	fts   ftsIndex
+}
+
+// NewRepository returns a Repository over the card database.
+func NewRepository(db persistence.Database) *Repository {
+	return &Repository{db: db}
+}
+
+// ByName returns every printing of the card with the exact name given, with
+// the default printing first. The name can be the full name of a card with
+// more than one face, like "Expansion // Explosion", or the name of either
+// face.
+func (r *Repository) ByName(name string) ([]Card, error) {
+	if name == "" {
+		return nil, errs.New("must provide name for card")
+	}
+	return r.query(`SELECT `+columns+` FROM "cards" WHERE "name" = ? OR "faceName" = ?`+defaultOrder, name, name)
+}
+
+// ByNames returns every printing of each of the named cards, with the
+// default printing of each card before its others. Like ByName, names can be
+// full names or face names.
+func (r *Repository) ByNames(names []string) ([]Card, error) {
+	if len(names) == 0 {
+		return []Card{}, nil
+	}
+	query, args, err := sqlx.In(`SELECT `+columns+` FROM "cards" WHERE "name" IN (?) OR "faceName" IN (?)`+defaultOrder, names, names)
+	if err != nil {
+		return nil, errs.New("error formatting sqlx query: %s", err)
+	}
+	return r.query(query, args...)
+}
+
+// NameLike returns every printing of the cards whose name contains name.
+func (r *Repository) NameLike(name string) ([]Card, error) {
+	return r.query(`SELECT `+columns+` FROM "cards" WHERE "name" LIKE ?`, fmt.Sprintf("%%%s%%", name))
+}
+
+// query runs a SELECT of `columns` and scans every row into a Card. Rows
+// that fail to scan are logged and skipped. The faces of multi-faced cards
+// are joined into one Card, in the order the first of them was found.
+func (r *Repository) query(query string, args ...interface{}) ([]Card, error) {
+	rows, err := r.scanAll(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return r.joinFaces("cards", rows)
+}
+
+// joinFaces joins the rows of multi-faced cards into one Card per printing,
+// looking up any faces that aren't in rows from table.
+func (r *Repository) joinFaces(table string, rows []Card) ([]Card, error) {
+	byUUID := map[string]Card{}
+	for _, c := range rows {
+		byUUID[c.UUID] = c
+	}
+	missing := []string{}
+	for _, c := range rows {
+		for _, id := range c.OtherFaceIDs {
+			if _, ok := byUUID[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		query, args, err := sqlx.In(`SELECT `+columns+` FROM "`+table+`" WHERE "uuid" IN (?)`, missing)
+		if err != nil {
+			return nil, errs.New("error formatting sqlx query: %s", err)
+		}
+		others, err := r.scanAll(query, args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range others {
+			byUUID[c.UUID] = c
+		}
+	}
+
+	out := []Card{}
+	seen := map[string]bool{}
+	for _, c := range rows {
+		if len(c.OtherFaceIDs) == 0 {
+			out = append(out, c)
+			continue
+		}
+		key := faceGroup(c)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		faces := []Card{c}
+		for _, id := range c.OtherFaceIDs {
+			if other, ok := byUUID[id]; ok {
+				faces = append(faces, other)
+			}
+		}
+		sort.SliceStable(faces, func(i, j int) bool {
+			return faces[i].Side < faces[j].Side
+		})
+		card := faces[0]
+		for _, f := range faces {
+			card.Faces = append(card.Faces, faceOf(f))
+		}
+		out = append(out, card)
+	}
+	return out, nil
+}
+
+// faceGroup returns a key shared by every face of the same printing.
+func faceGroup(c Card) string {
+	key := c.UUID
+	for _, id := range c.OtherFaceIDs {
+		if id < key {
+			key = id
+		}
+	}
+	return key
+}
+
+// scanAll runs a SELECT of `columns` and scans every row into a Card without
+// joining faces.
+func (r *Repository) scanAll(query string, args ...interface{}) ([]Card, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, errs.New("failed to run query: %s", err)
+	}
+	defer rows.Close()
+
+	cards := []Card{}
+	for rows.Next() {
+		card, err := scan(rows)
+		if err != nil {
+			log.Printf("error scanning rows for card query: %s", err)
+			continue
+		}
+		cards = append(cards, card)
+	}
+
+	return cards, errs.Wrap(rows.Err())
+}
+
+// scan reads a row of `columns` into a Card.
+func scan(rows *sql.Rows) (Card, error) {
+	var (
+		id                     int
+		uuid                   *string
+		name                   string
+		layout                 *string
+		faceName               *string
+		side                   *string
+		otherFaceIDs           *string
+		setCode                *string
+		number                 *string
+		rarity                 *string
+		colors                 *string
+		colorIdentity          *string
+		convertedManaCost      *float64
+		faceConvertedManaCost  *float64
+		manaCost               *string
+		power                  *string
+		toughness              *string
+		loyalty                *string
+		typeLine               *string
+		types                  *string
+		subtypes               *string
+		supertypes             *string
+		keywords               *string
+		text                   *string
+		isTextless             *int
+		tcgplayerProductID     *int
+		scryfallID             *string
+		scryfallIllustrationID *string
+		scryfallOracleID       *string
+		isPromo                *int
+		isOnlineOnly           *int
+	)
+
+	if err := rows.Scan(&id, &uuid, &name, &layout, &faceName, &side,
+		&otherFaceIDs, &setCode, &number, &rarity, &colors, &colorIdentity,
+		&convertedManaCost, &faceConvertedManaCost, &manaCost, &power,
+		&toughness, &loyalty, &typeLine, &types, &subtypes, &supertypes,
+		&keywords, &text, &isTextless, &tcgplayerProductID, &scryfallID,
+		&scryfallIllustrationID, &scryfallOracleID, &isPromo,
+		&isOnlineOnly); err != nil {
+		return Card{}, err
+	}
+
+	card := Card{
+		ID:                     id,
+		UUID:                   str(uuid),
+		Name:                   name,
+		Layout:                 str(layout),
+		FaceName:               str(faceName),
+		Side:                   str(side),
+		OtherFaceIDs:           splitList(otherFaceIDs),
+		SetCode:                str(setCode),
+		Number:                 str(number),
+		Rarity:                 str(rarity),
+		Colors:                 splitList(colors),
+		ColorIdentity:          splitList(colorIdentity),
+		FaceCMC:                faceConvertedManaCost,
+		ManaCost:               str(manaCost),
+		Power:                  str(power),
+		Toughness:              str(toughness),
+		Loyalty:                str(loyalty),
+		TypeLine:               str(typeLine),
+		Types:                  splitList(types),
+		Subtypes:               splitList(subtypes),
+		Supertypes:             splitList(supertypes),
+		Keywords:               splitList(keywords),
+		Text:                   str(text),
+		IsTextless:             isTextless != nil && *isTextless != 0,
+		IsPromo:                isPromo != nil && *isPromo != 0,
+		IsOnlineOnly:           isOnlineOnly != nil && *isOnlineOnly != 0,
+		ScryfallID:             str(scryfallID),
+		ScryfallIllustrationID: str(scryfallIllustrationID),
+		ScryfallOracleID:       str(scryfallOracleID),
+	}
+	if convertedManaCost != nil {
+		card.CMC = *convertedManaCost
+	}
+	if tcgplayerProductID != nil {
+		card.TCGPlayerProductID = *tcgplayerProductID
+	}
+
+	return card, nil
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
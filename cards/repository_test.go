@@ -0,0 +1,168 @@
+package cards
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// newTestDB imports testdata/AllPrintings.json into a temporary card
+// database. The returned func removes it.
+func newTestDB(t testing.TB) (*persistence.DB, func()) {
+	dir, err := ioutil.TempDir("", "edhgo-cards")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	db, err := persistence.NewSQLite(filepath.Join(dir, "cards.sqlite"))
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	f, err := OpenImportFile("testdata/AllPrintings.json")
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := Import(db, f); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	return db, cleanup
+}
+
+func TestRepository(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	t.Run("test by name", func(t *testing.T) {
+		found, err := repo.ByName("Teysa Karlov")
+		assert.NoError(t, err)
+		assert.Len(t, found, 1)
+
+		teysa := found[0]
+		assert.NotZero(t, teysa.ID)
+		assert.Equal(t, "RNA", teysa.SetCode)
+		assert.Equal(t, []string{"B", "W"}, teysa.Colors)
+		assert.Equal(t, "4", teysa.CMCString())
+		assert.Equal(t, "2", teysa.Power)
+		assert.Equal(t, "4", teysa.Toughness)
+		assert.Equal(t, "Legendary Creature — Human Advisor", teysa.TypeLine)
+		assert.True(t, teysa.HasType("creature"))
+		assert.True(t, teysa.HasType("Advisor"))
+		assert.False(t, teysa.HasType("Land"))
+		assert.Equal(t, 180769, teysa.TCGPlayerProductID)
+		assert.Equal(t, "illus-teysa", teysa.ScryfallIllustrationID)
+
+		_, err = repo.ByName("")
+		assert.Error(t, err)
+
+		found, err = repo.ByName("Not A Card")
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("test nullable columns", func(t *testing.T) {
+		found, err := repo.ByName("Swamp")
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, "", found[0].Power)
+		assert.Equal(t, "", found[0].ManaCost)
+		assert.Equal(t, []string{}, found[0].Colors)
+		assert.Equal(t, "0", found[0].CMCString())
+	})
+
+	t.Run("test by names", func(t *testing.T) {
+		found, err := repo.ByNames([]string{"Swamp", "Teysa Karlov", "Not A Card"})
+		assert.NoError(t, err)
+		assert.Len(t, found, 3)
+
+		found, err = repo.ByNames(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("test multi-faced cards", func(t *testing.T) {
+		for _, name := range []string{"Expansion // Explosion", "Expansion", "Explosion"} {
+			found, err := repo.ByName(name)
+			assert.NoError(t, err)
+			assert.Len(t, found, 1, name)
+			assert.Equal(t, "Expansion // Explosion", found[0].Name)
+		}
+
+		found, err := repo.ByName("Explosion")
+		assert.NoError(t, err)
+		card := found[0]
+		assert.Equal(t, "Expansion", card.FaceName)
+		assert.True(t, card.IsMultiFaced())
+		assert.False(t, card.CanTransform())
+		assert.Len(t, card.Faces, 2)
+		assert.Equal(t, "Expansion", card.Faces[0].Name)
+		assert.Equal(t, "{U/R}{U/R}", card.Faces[0].ManaCost)
+		assert.Equal(t, 2.0, card.Faces[0].CMC)
+		assert.Equal(t, "Explosion", card.Faces[1].Name)
+		assert.Equal(t, "{X}{U}{U}{R}{R}", card.Faces[1].ManaCost)
+		assert.Equal(t, 6.0, card.Faces[1].CMC)
+		assert.Equal(t, []string{"Sorcery"}, card.Faces[1].Types)
+
+		found, err = repo.ByName("Insectile Aberration")
+		assert.NoError(t, err)
+		assert.Len(t, found, 1)
+		assert.True(t, found[0].CanTransform())
+		assert.Equal(t, "3", found[0].Faces[1].Power)
+		assert.Equal(t, []string{"Flying"}, found[0].Faces[1].Keywords)
+
+		found, err = repo.ByNames([]string{"Stomp", "Kenzo the Hardhearted"})
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		for _, c := range found {
+			switch c.Layout {
+			case "adventure":
+				assert.False(t, c.CanTransform())
+				assert.Equal(t, "Bonecrusher Giant", c.FaceName)
+			case "flip":
+				assert.True(t, c.CanTransform())
+				assert.Equal(t, "Kenzo the Hardhearted", c.Faces[1].Name)
+			default:
+				t.Errorf("unexpected layout %q", c.Layout)
+			}
+		}
+
+		found, err = repo.ByName("Swamp")
+		assert.NoError(t, err)
+		assert.False(t, found[0].IsMultiFaced())
+		assert.Empty(t, found[0].Faces)
+	})
+
+	t.Run("test default printing", func(t *testing.T) {
+		found, err := repo.ByName("Swamp")
+		assert.NoError(t, err)
+		assert.Equal(t, "RNA", found[0].SetCode, "newest set first")
+		assert.Equal(t, "LEA", found[1].SetCode)
+
+		found, err = repo.ByName("Lim-Dûl's Vault")
+		assert.NoError(t, err)
+		assert.Len(t, found, 3)
+		assert.Equal(t, "ALL", found[0].SetCode, "paper, non-promo printings first")
+		assert.Equal(t, "PRM", found[1].SetCode)
+		assert.True(t, found[1].IsPromo)
+		assert.Equal(t, "ME2", found[2].SetCode)
+		assert.True(t, found[2].IsOnlineOnly)
+	})
+
+	t.Run("test name like", func(t *testing.T) {
+		found, err := repo.NameLike("xplo")
+		assert.NoError(t, err)
+		assert.Len(t, found, 1)
+		assert.Equal(t, "Expansion // Explosion", found[0].Name)
+	})
+}
@@ -0,0 +1,281 @@
+package cards
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+)
+
+// maxSuggestions is how many "did you mean" names a Resolution holds.
+const maxSuggestions = 5
+
+// MatchKind is how a name was matched to a card.
+type MatchKind string
+
+const (
+	// MatchExact is an exact name match.
+	MatchExact MatchKind = "exact"
+	// MatchCase matched the name ignoring case.
+	MatchCase MatchKind = "case"
+	// MatchAccent matched the name ignoring case and accents, e.g.
+	// "Lim-Dul's Vault" for "Lim-Dûl's Vault".
+	MatchAccent MatchKind = "accent"
+	// MatchFace matched one face of a card with more than one, e.g.
+	// "Delver of Secrets" for "Delver of Secrets // Insectile Aberration".
+	MatchFace MatchKind = "face"
+	// MatchFuzzy matched a close misspelling. Callers should confirm it.
+	MatchFuzzy MatchKind = "fuzzy"
+	// MatchNone didn't match any card.
+	MatchNone MatchKind = "none"
+)
+
+// Resolution is the result of resolving a name from a decklist to a card.
+type Resolution struct {
+	Query string
+	Match MatchKind
+	// Name is the card's name in the card database. It's empty if Match is
+	// MatchNone.
+	Name string
+	// Printings holds every printing of the resolved card.
+	Printings []Card
+	// Suggestions holds close names when the match was fuzzy or failed.
+	Suggestions []string
+}
+
+// Resolved returns true if the name was matched to a card.
+func (r Resolution) Resolved() bool {
+	return r.Match != MatchNone
+}
+
+// nameIndex holds every card name in the card database, folded for
+// comparison. It is loaded once since the card database is read only.
+type nameIndex struct {
+	once sync.Once
+	err  error
+
+	// names maps folded names to card names.
+	names map[string]string
+	// faces maps folded face names to the names of the cards they're on.
+	faces map[string]string
+	// folded lists the folded names, sorted, for fuzzy matching.
+	folded []string
+}
+
+// Resolve finds the card a player meant by name. It tries, in order, an
+// exact match, a case insensitive match, an accent insensitive match, a
+// match on one face of a split or double-faced card, and finally the closest
+// names by edit distance. A close misspelling with one clear winner is
+// resolved with MatchFuzzy; otherwise the Resolution holds suggestions.
+func (r *Repository) Resolve(name string) (Resolution, error) {
+	res := Resolution{Query: name, Match: MatchNone, Suggestions: []string{}}
+	name = normalizeName(name)
+	if name == "" {
+		return res, errs.New("must provide name for card")
+	}
+
+	printings, err := r.ByName(name)
+	if err != nil {
+		return res, err
+	}
+	if len(printings) > 0 {
+		res.Match, res.Name, res.Printings = MatchExact, printings[0].Name, printings
+		if res.Name != name {
+			res.Match = MatchFace
+		}
+		return res, nil
+	}
+
+	idx, err := r.index()
+	if err != nil {
+		return res, err
+	}
+
+	match, found, suggestions := idx.match(name)
+	res.Suggestions = suggestions
+	if match == MatchNone {
+		return res, nil
+	}
+
+	printings, err = r.ByName(found)
+	if err != nil {
+		return res, err
+	}
+	if len(printings) == 0 {
+		return res, nil
+	}
+	res.Match, res.Name, res.Printings = match, found, printings
+	return res, nil
+}
+
+// match finds the card a name that isn't an exact card or face name refers
+// to, without querying the card database. It returns the card's name, or
+// MatchNone, along with any suggestions.
+func (idx *nameIndex) match(name string) (MatchKind, string, []string) {
+	key := fold(name)
+	switch {
+	case idx.names[key] != "":
+		found := idx.names[key]
+		if strings.EqualFold(found, name) {
+			return MatchCase, found, []string{}
+		}
+		return MatchAccent, found, []string{}
+	case idx.faces[key] != "":
+		return MatchFace, idx.faces[key], []string{}
+	}
+
+	suggestions, best := idx.suggest(key)
+	if len(suggestions) == 0 || best > fuzzyThreshold(key) {
+		return MatchNone, "", suggestions
+	}
+	if len(suggestions) > 1 && distance(key, fold(suggestions[1])) == best {
+		// a tie is too ambiguous to pick for the player
+		return MatchNone, "", suggestions
+	}
+	return MatchFuzzy, suggestions[0], suggestions
+}
+
+// index loads the name index on first use.
+func (r *Repository) index() (*nameIndex, error) {
+	r.names.once.Do(func() {
+		rows, err := r.db.Query(`SELECT DISTINCT "name", "faceName" FROM "cards"`)
+		if err != nil {
+			r.names.err = errs.New("failed to load card names: %s", err)
+			return
+		}
+		defer rows.Close()
+
+		r.names.names = map[string]string{}
+		r.names.faces = map[string]string{}
+		for rows.Next() {
+			var (
+				name     string
+				faceName *string
+			)
+			if err := rows.Scan(&name, &faceName); err != nil {
+				r.names.err = errs.Wrap(err)
+				return
+			}
+			r.names.names[fold(name)] = name
+			if faceName != nil && *faceName != "" {
+				r.names.faces[fold(*faceName)] = name
+			}
+		}
+		for k := range r.names.names {
+			r.names.folded = append(r.names.folded, k)
+		}
+		sort.Strings(r.names.folded)
+		r.names.err = errs.Wrap(rows.Err())
+	})
+	return &r.names, r.names.err
+}
+
+// suggest returns the closest card names to the folded name, closest first,
+// and the edit distance of the closest.
+func (idx *nameIndex) suggest(key string) ([]string, int) {
+	type candidate struct {
+		name string
+		dist int
+	}
+	limit := fuzzyThreshold(key) * 2
+	candidates := []candidate{}
+	for _, folded := range idx.folded {
+		// names that differ in length by more than the limit can't be close
+		if d := len(folded) - len(key); d > limit || -d > limit {
+			continue
+		}
+		if d := distance(key, folded); d <= limit {
+			candidates = append(candidates, candidate{idx.names[folded], d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+
+	out := []string{}
+	for i, c := range candidates {
+		if i == maxSuggestions {
+			break
+		}
+		out = append(out, c.name)
+	}
+	if len(candidates) == 0 {
+		return out, 0
+	}
+	return out, candidates[0].dist
+}
+
+// fuzzyThreshold is the most edits a misspelling of name can have and still
+// be resolved automatically.
+func fuzzyThreshold(name string) int {
+	if n := len(name) / 8; n > 2 {
+		return n
+	}
+	return 2
+}
+
+// normalizeName trims a name and writes split card separators the way the
+// card database does, e.g. "Fire/Ice" becomes "Fire // Ice".
+func normalizeName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if strings.Contains(name, "/") && !strings.Contains(name, " // ") {
+		parts := strings.Split(name, "/")
+		clean := []string{}
+		for _, p := range parts {
+			if p = strings.TrimSpace(p); p != "" {
+				clean = append(clean, p)
+			}
+		}
+		name = strings.Join(clean, " // ")
+	}
+	return name
+}
+
+// accents maps accented letters used in card names to plain ones.
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c", "æ", "ae", "œ", "oe", "ß", "ss",
+	"’", "'", "‘", "'",
+)
+
+// fold lowercases a name and strips its accents for comparison.
+func fold(name string) string {
+	return accents.Replace(strings.ToLower(name))
+}
+
+// distance is the Levenshtein edit distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
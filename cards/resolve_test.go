@@ -0,0 +1,76 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	cases := []struct {
+		query string
+		match MatchKind
+		name  string
+	}{
+		{"Teysa Karlov", MatchExact, "Teysa Karlov"},
+		{"  teysa   KARLOV ", MatchCase, "Teysa Karlov"},
+		{"Lim-Dul's Vault", MatchAccent, "Lim-Dûl's Vault"},
+		{"lim-dûl’s vault", MatchAccent, "Lim-Dûl's Vault"},
+		{"Delver of Secrets", MatchFace, "Delver of Secrets // Insectile Aberration"},
+		{"expansion", MatchFace, "Expansion // Explosion"},
+		{"Insectile Aberration", MatchFace, "Delver of Secrets // Insectile Aberration"},
+		{"stomp", MatchFace, "Bonecrusher Giant // Stomp"},
+		{"Expansion/Explosion", MatchExact, "Expansion // Explosion"},
+		{"Expansion / Explosion", MatchExact, "Expansion // Explosion"},
+		{"Teysa Karlvo", MatchFuzzy, "Teysa Karlov"},
+		{"Sera Angel", MatchFuzzy, "Serra Angel"},
+		{"Sera Angle", MatchNone, ""},
+		{"Black Lotus", MatchNone, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			res, err := repo.Resolve(c.query)
+			assert.NoError(t, err)
+			assert.Equal(t, c.query, res.Query)
+			assert.Equal(t, c.match, res.Match)
+			assert.Equal(t, c.name, res.Name)
+			assert.Equal(t, c.match != MatchNone, res.Resolved())
+			if res.Resolved() {
+				assert.NotEmpty(t, res.Printings)
+				assert.Equal(t, c.name, res.Printings[0].Name)
+			}
+		})
+	}
+
+	t.Run("test suggestions", func(t *testing.T) {
+		res, err := repo.Resolve("Teysa Karlvo")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Teysa Karlov"}, res.Suggestions)
+
+		res, err = repo.Resolve("Sera Angle")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel"}, res.Suggestions)
+
+		res, err = repo.Resolve("Swam")
+		assert.NoError(t, err)
+		assert.Equal(t, MatchFuzzy, res.Match)
+		assert.Contains(t, res.Suggestions, "Swamp")
+	})
+
+	t.Run("test empty name", func(t *testing.T) {
+		_, err := repo.Resolve("  ")
+		assert.Error(t, err)
+	})
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, distance("shock", "shock"))
+	assert.Equal(t, 1, distance("shock", "shok"))
+	assert.Equal(t, 2, distance("karlov", "karlvo"))
+	assert.Equal(t, 3, distance("", "abc"))
+	assert.Equal(t, 1, distance("dûl", "dul"))
+}
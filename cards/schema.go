@@ -0,0 +1,135 @@
+package cards
+
+import (
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// Migrations is the ordered list of schema migrations for the card
+// database. Column names follow MTGJSON so that an AllPrintings.sqlite
+// downloaded from MTGJSON can be queried the same way as one built with
+// Import, and migrations that MTGJSON's database already has are skipped.
+// List columns such as colors and types are stored comma separated. Tokens
+// are kept in their own table with the same columns as cards, like MTGJSON
+// does.
+var Migrations = []persistence.Migration{
+	{
+		Version: 1,
+		Name:    "create cards",
+		Up: `CREATE TABLE IF NOT EXISTS "cards" (` + cardsColumns + `);` + cardsIndexes + `
+		CREATE TABLE IF NOT EXISTS "sets" (
+			"code" TEXT PRIMARY KEY,
+			"name" TEXT,
+			"releaseDate" TEXT
+		);`,
+		Down: `DROP TABLE "sets";
+		DROP TABLE "cards";`,
+	},
+	{
+		Version: 2,
+		Name:    "add printing columns",
+		Up: `ALTER TABLE "cards" ADD COLUMN "isPromo" INTEGER;
+		ALTER TABLE "cards" ADD COLUMN "isOnlineOnly" INTEGER;
+		CREATE INDEX IF NOT EXISTS "cards_uuid" ON "cards" ("uuid");`,
+		// SQLite can't drop columns, so the table is rebuilt without them.
+		Down: `CREATE TABLE "cards_before_printings" (` + cardsColumns + `);
+		INSERT INTO "cards_before_printings" SELECT
+			"id", "uuid", "name", "asciiName", "faceName", "side", "layout",
+			"otherFaceIds", "setCode", "number", "rarity", "colors",
+			"colorIdentity", "convertedManaCost", "faceConvertedManaCost",
+			"manaCost", "power", "toughness", "loyalty", "type", "types",
+			"subtypes", "supertypes", "keywords", "text", "isTextless",
+			"tcgplayerProductId", "scryfallId", "scryfallIllustrationId",
+			"scryfallOracleId"
+		FROM "cards";
+		DROP TABLE "cards";
+		ALTER TABLE "cards_before_printings" RENAME TO "cards";` + cardsIndexes,
+		Exists: `SELECT COUNT(*) FROM pragma_table_info('cards') WHERE name = 'isPromo'`,
+	},
+	{
+		Version: 3,
+		Name:    "create tokens",
+		Up: `CREATE TABLE IF NOT EXISTS "tokens" (
+			"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+			"uuid" TEXT NOT NULL UNIQUE,
+			"name" TEXT NOT NULL,
+			"asciiName" TEXT,
+			"faceName" TEXT,
+			"side" TEXT,
+			"layout" TEXT,
+			"otherFaceIds" TEXT,
+			"setCode" TEXT,
+			"number" TEXT,
+			"rarity" TEXT,
+			"colors" TEXT,
+			"colorIdentity" TEXT,
+			"convertedManaCost" REAL,
+			"faceConvertedManaCost" REAL,
+			"manaCost" TEXT,
+			"power" TEXT,
+			"toughness" TEXT,
+			"loyalty" TEXT,
+			"type" TEXT,
+			"types" TEXT,
+			"subtypes" TEXT,
+			"supertypes" TEXT,
+			"keywords" TEXT,
+			"text" TEXT,
+			"isTextless" INTEGER,
+			"tcgplayerProductId" INTEGER,
+			"scryfallId" TEXT,
+			"scryfallIllustrationId" TEXT,
+			"scryfallOracleId" TEXT,
+			"isPromo" INTEGER,
+			"isOnlineOnly" INTEGER
+		);
+		CREATE INDEX IF NOT EXISTS "tokens_name_nocase" ON "tokens" ("name" COLLATE NOCASE);`,
+		Down: `DROP TABLE "tokens";`,
+	},
+}
+
+// cardsColumns are the columns the cards table was created with.
+const cardsColumns = `
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"uuid" TEXT NOT NULL UNIQUE,
+	"name" TEXT NOT NULL,
+	"asciiName" TEXT,
+	"faceName" TEXT,
+	"side" TEXT,
+	"layout" TEXT,
+	"otherFaceIds" TEXT,
+	"setCode" TEXT,
+	"number" TEXT,
+	"rarity" TEXT,
+	"colors" TEXT,
+	"colorIdentity" TEXT,
+	"convertedManaCost" REAL,
+	"faceConvertedManaCost" REAL,
+	"manaCost" TEXT,
+	"power" TEXT,
+	"toughness" TEXT,
+	"loyalty" TEXT,
+	"type" TEXT,
+	"types" TEXT,
+	"subtypes" TEXT,
+	"supertypes" TEXT,
+	"keywords" TEXT,
+	"text" TEXT,
+	"isTextless" INTEGER,
+	"tcgplayerProductId" INTEGER,
+	"scryfallId" TEXT,
+	"scryfallIllustrationId" TEXT,
+	"scryfallOracleId" TEXT
+`
+
+// cardsIndexes are the indexes the cards table was created with.
+const cardsIndexes = `
+CREATE INDEX IF NOT EXISTS "cards_name" ON "cards" ("name");
+CREATE INDEX IF NOT EXISTS "cards_name_nocase" ON "cards" ("name" COLLATE NOCASE);
+CREATE INDEX IF NOT EXISTS "cards_faceName" ON "cards" ("faceName");
+CREATE INDEX IF NOT EXISTS "cards_set_number" ON "cards" ("setCode", "number");`
+
+// EnsureSchema creates the card database tables if needed and brings an
+// older card database up to date. It's safe to run on every start.
+func EnsureSchema(db *persistence.DB) error {
+	return persistence.Migrate(db, Migrations)
+}
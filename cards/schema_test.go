@@ -0,0 +1,64 @@
+package cards
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+func TestEnsureSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edhgo-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	open := func(t *testing.T, name string) *persistence.DB {
+		db, err := persistence.NewSQLite(filepath.Join(dir, name))
+		assert.NoError(t, err)
+		return db
+	}
+	hasColumn := func(t *testing.T, db *persistence.DB, name string) bool {
+		rows, err := db.Query(`SELECT COUNT(*) FROM pragma_table_info('cards') WHERE name = ?`, name)
+		assert.NoError(t, err)
+		defer rows.Close()
+		n := 0
+		assert.True(t, rows.Next())
+		assert.NoError(t, rows.Scan(&n))
+		return n == 1
+	}
+
+	t.Run("test upgrades a card database from before migrations", func(t *testing.T) {
+		db := open(t, "old.sqlite")
+		_, err := db.Exec(`CREATE TABLE "cards" (` + cardsColumns + `);`)
+		assert.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO "cards" ("uuid", "name") VALUES ('a', 'Swamp')`)
+		assert.NoError(t, err)
+
+		assert.NoError(t, EnsureSchema(db))
+		assert.True(t, hasColumn(t, db, "isPromo"))
+		assert.True(t, hasColumn(t, db, "isOnlineOnly"))
+		version, err := persistence.SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, len(Migrations), version)
+
+		assert.NoError(t, persistence.MigrateTo(db, Migrations, 1))
+		assert.False(t, hasColumn(t, db, "isPromo"))
+		rows, err := db.Query(`SELECT "name" FROM "cards"`)
+		assert.NoError(t, err)
+		defer rows.Close()
+		assert.True(t, rows.Next(), "rows are kept")
+	})
+
+	t.Run("test skips columns the database already has", func(t *testing.T) {
+		db := open(t, "mtgjson.sqlite")
+		_, err := db.Exec(`CREATE TABLE "cards" (` + cardsColumns + `, "isPromo" INTEGER, "isOnlineOnly" INTEGER);`)
+		assert.NoError(t, err)
+
+		assert.NoError(t, EnsureSchema(db))
+		assert.NoError(t, EnsureSchema(db), "running again is a no-op")
+	})
+}
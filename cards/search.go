@@ -0,0 +1,320 @@
+package cards
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// DefaultSearchLimit is how many cards a search returns when no limit is
+	// given.
+	DefaultSearchLimit = 50
+	// MaxSearchLimit caps how many cards a single search can return.
+	MaxSearchLimit = 500
+)
+
+// colorOrder is every color symbol, in WUBRG order.
+var colorOrder = []string{"W", "U", "B", "R", "G"}
+
+// ColorMatch is how a ColorFilter compares its colors to a card's.
+type ColorMatch int
+
+const (
+	// ColorsInclude matches cards with at least all of the given colors.
+	ColorsInclude ColorMatch = iota
+	// ColorsExactly matches cards with exactly the given colors. No colors
+	// matches colorless cards.
+	ColorsExactly
+	// ColorsWithin matches cards with no colors outside of the given ones,
+	// which is how commander color identity works.
+	ColorsWithin
+)
+
+// ColorFilter filters on a card's colors or color identity.
+type ColorFilter struct {
+	Colors []string
+	Match  ColorMatch
+}
+
+// Comparison compares a numeric card field to Value. Op is one of
+// `=`, `!=`, `<`, `<=`, `>`, or `>=`.
+type Comparison struct {
+	Op    string
+	Value float64
+}
+
+// Filter describes a card search. Every field is optional, and a card must
+// match all of the fields that are set. String matches ignore case.
+type Filter struct {
+	// Query matches cards with every one of its words in their name, type
+	// line, or oracle text.
+	Query string
+
+	// Name matches cards whose name contains it, and Names matches cards
+	// whose name contains every entry.
+	Name  string
+	Names []string
+
+	Colors        *ColorFilter
+	ColorIdentity *ColorFilter
+
+	// Keywords, Types, and Text match cards that have every entry. Types
+	// are matched against the full type line, so supertypes and subtypes
+	// work too. Text is matched against the oracle text.
+	Keywords []string
+	Types    []string
+	Text     []string
+
+	CMC       []Comparison
+	Power     []Comparison
+	Toughness []Comparison
+
+	// Rarities and Sets match cards with any of their entries.
+	Rarities []string
+	Sets     []string
+
+	Limit  int
+	Offset int
+}
+
+// Search returns the printings that match the filter, ordered by name.
+//
+// When the card database has a full-text index, Query, Name, Names, Types,
+// and Text are matched against it instead: their words match the start of
+// words in the card, and results are ranked by how well they match, with
+// name matches first.
+func (r *Repository) Search(f Filter) ([]Card, error) {
+	return r.search(f, r.hasFTS())
+}
+
+func (r *Repository) search(f Filter, useFTS bool) ([]Card, error) {
+	where, match, args, err := f.where(useFTS)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each face of a multi-faced card is a row, so matches are collapsed to
+	// their front face before paging, keeping the best rank of any face. The
+	// LIMIT stops SQLite from flattening the full-text subquery, which bm25
+	// can't be used outside of.
+	matched := `SELECT ` + frontFaceID + ` AS "frontId", 0 AS "rank" FROM "cards"`
+	if match != "" {
+		matched = `SELECT ` + frontFaceID + ` AS "frontId", "rank" FROM "cards"
+			JOIN (SELECT "rowid" AS "ftsId", bm25("cards_fts", ` + ftsWeights + `) AS "rank"
+			FROM "cards_fts" WHERE "cards_fts" MATCH ? LIMIT -1) ON "ftsId" = "id"`
+		args = append([]interface{}{match}, args...)
+	}
+	if where != "" {
+		matched += ` WHERE ` + where
+	}
+
+	limit, offset := f.page()
+	query := `SELECT ` + columns + ` FROM "cards"
+		JOIN (SELECT "frontId", MIN("rank") AS "bestRank" FROM (` + matched + `) GROUP BY "frontId")
+		ON "frontId" = "id"
+		ORDER BY "bestRank", "name", "id" LIMIT ? OFFSET ?`
+
+	return r.query(query, append(args, limit, offset)...)
+}
+
+// frontFaceID selects the id of the front face of the card on each row of
+// the cards table. It's the row's own id for cards with one face.
+const frontFaceID = `CASE WHEN "side" IS NULL OR "side" = 'a' THEN "id" ELSE COALESCE(
+	(SELECT "front"."id" FROM "cards" AS "front"
+		WHERE "front"."name" = "cards"."name" AND "front"."side" = 'a'
+		AND instr(',' || "cards"."otherFaceIds" || ',', ',' || "front"."uuid" || ',') > 0),
+	"id") END`
+
+// page returns the limit and offset to use for the filter.
+func (f Filter) page() (limit, offset int) {
+	limit = f.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	offset = f.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// IsEmpty returns true if the filter doesn't filter anything.
+func (f Filter) IsEmpty() bool {
+	return f.Query == "" && f.Name == "" && len(f.Names) == 0 && f.Colors == nil && f.ColorIdentity == nil &&
+		len(f.Keywords) == 0 && len(f.Types) == 0 && len(f.Text) == 0 &&
+		len(f.CMC) == 0 && len(f.Power) == 0 && len(f.Toughness) == 0 &&
+		len(f.Rarities) == 0 && len(f.Sets) == 0
+}
+
+// where builds the WHERE clause and its arguments for the filter. If useFTS
+// is true, the text fields are returned as an FTS5 match expression
+// instead, except for any that have no words to match.
+func (f Filter) where(useFTS bool) (string, string, []interface{}, error) {
+	var (
+		clauses []string
+		matches []string
+		args    []interface{}
+	)
+	add := func(clause string, a ...interface{}) {
+		clauses = append(clauses, clause)
+		args = append(args, a...)
+	}
+	// like matches s against column with LIKE, or against the full-text
+	// index with match when possible.
+	like := func(column, s string, match func(column, s string) string) {
+		if useFTS {
+			if m := match(column, s); m != "" {
+				matches = append(matches, m)
+				return
+			}
+		}
+		add(`"`+column+`" LIKE ? ESCAPE '\'`, contains(s))
+	}
+
+	for _, w := range strings.Fields(f.Query) {
+		if useFTS {
+			if m := matchPrefixes("", w); m != "" {
+				matches = append(matches, m)
+				continue
+			}
+		}
+		add(`("name" LIKE ? ESCAPE '\' OR "type" LIKE ? ESCAPE '\' OR "text" LIKE ? ESCAPE '\')`,
+			contains(w), contains(w), contains(w))
+	}
+	if f.Name != "" {
+		like("name", f.Name, matchPrefixes)
+	}
+	for _, n := range f.Names {
+		like("name", n, matchPrefixes)
+	}
+
+	for _, cf := range []struct {
+		column string
+		filter *ColorFilter
+	}{
+		{"colors", f.Colors},
+		{"colorIdentity", f.ColorIdentity},
+	} {
+		if cf.filter == nil {
+			continue
+		}
+		clause, err := colorClause(cf.column, *cf.filter)
+		if err != nil {
+			return "", "", nil, err
+		}
+		add(clause)
+	}
+
+	for _, k := range f.Keywords {
+		add(`(',' || "keywords" || ',') LIKE ? ESCAPE '\'`, contains(","+k+","))
+	}
+	for _, t := range f.Types {
+		like("type", t, matchPrefixes)
+	}
+	for _, t := range f.Text {
+		like("text", t, matchPhrase)
+	}
+
+	for _, cmp := range []struct {
+		column      string
+		comparisons []Comparison
+	}{
+		{"convertedManaCost", f.CMC},
+		{"power", f.Power},
+		{"toughness", f.Toughness},
+	} {
+		for _, c := range cmp.comparisons {
+			clause, err := comparisonClause(cmp.column, c)
+			if err != nil {
+				return "", "", nil, err
+			}
+			add(clause, c.Value)
+		}
+	}
+
+	if len(f.Rarities) > 0 {
+		add(`LOWER("rarity") IN (`+placeholders(len(f.Rarities))+`)`, lowerArgs(f.Rarities)...)
+	}
+	if len(f.Sets) > 0 {
+		add(`LOWER("setCode") IN (`+placeholders(len(f.Sets))+`)`, lowerArgs(f.Sets)...)
+	}
+
+	return strings.Join(clauses, " AND "), strings.Join(matches, " AND "), args, nil
+}
+
+// colorClause matches a comma separated color column against a ColorFilter.
+func colorClause(column string, cf ColorFilter) (string, error) {
+	want := map[string]bool{}
+	for _, c := range cf.Colors {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if !isColor(c) {
+			return "", errs.New("invalid color %q; must be one of W, U, B, R, or G", c)
+		}
+		want[c] = true
+	}
+
+	has := func(c string) string {
+		return fmt.Sprintf(`(',' || COALESCE("%s", '') || ',') LIKE '%%,%s,%%'`, column, c)
+	}
+
+	var parts []string
+	for _, c := range colorOrder {
+		switch {
+		case want[c] && cf.Match != ColorsWithin:
+			parts = append(parts, has(c))
+		case !want[c] && cf.Match != ColorsInclude:
+			parts = append(parts, "NOT "+has(c))
+		}
+	}
+	if len(parts) == 0 {
+		return "1", nil
+	}
+	return "(" + strings.Join(parts, " AND ") + ")", nil
+}
+
+// comparisonClause compares a numeric column. Power and toughness are text
+// columns, so values that aren't numbers, like `*`, never match.
+func comparisonClause(column string, c Comparison) (string, error) {
+	switch c.Op {
+	case "=", "!=", "<", "<=", ">", ">=":
+	default:
+		return "", errs.New("invalid comparison %q", c.Op)
+	}
+	if column == "convertedManaCost" {
+		return fmt.Sprintf(`"%s" %s ?`, column, c.Op), nil
+	}
+	return fmt.Sprintf(`("%s" GLOB '[0-9]*' AND CAST("%s" AS REAL) %s ?)`, column, column, c.Op), nil
+}
+
+func isColor(c string) bool {
+	for _, o := range colorOrder {
+		if c == o {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns a LIKE pattern matching s anywhere, with LIKE wildcards
+// in s escaped.
+func contains(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + r.Replace(s) + "%"
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func lowerArgs(vals []string) []interface{} {
+	out := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		out = append(out, strings.ToLower(v))
+	}
+	return out
+}
@@ -0,0 +1,183 @@
+package cards
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	names := func(t *testing.T, f Filter) []string {
+		found, err := repo.Search(f)
+		assert.NoError(t, err)
+		out := []string{}
+		for _, c := range found {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+
+	cases := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{"name", Filter{Name: "ex"}, []string{"Expansion // Explosion"}},
+		{"query", Filter{Query: "flying angel"}, []string{"Serra Angel"}},
+		{"query matches type line", Filter{Query: "advisor"}, []string{"Teysa Karlov"}},
+		{"name is case insensitive", Filter{Name: "SWAMP"}, []string{"Swamp", "Swamp"}},
+		{"name words", Filter{Names: []string{"angel", "serra"}}, []string{"Serra Angel"}},
+		{"name escapes wildcards", Filter{Name: "%"}, []string{}},
+		{"colors include", Filter{Colors: &ColorFilter{Colors: []string{"w"}}}, []string{"Bushi Tenderfoot // Kenzo the Hardhearted", "Serra Angel", "Teysa Karlov"}},
+		{"colors exactly", Filter{Colors: &ColorFilter{Colors: []string{"W"}, Match: ColorsExactly}}, []string{"Bushi Tenderfoot // Kenzo the Hardhearted", "Serra Angel"}},
+		{"colorless", Filter{Colors: &ColorFilter{Match: ColorsExactly}}, []string{"Swamp", "Swamp"}},
+		{"color identity within", Filter{ColorIdentity: &ColorFilter{Colors: []string{"B", "W"}, Match: ColorsWithin}}, []string{"Bushi Tenderfoot // Kenzo the Hardhearted", "Serra Angel", "Swamp", "Swamp", "Teysa Karlov"}},
+		{"keywords match any face", Filter{Keywords: []string{"flying"}}, []string{"Delver of Secrets // Insectile Aberration", "Serra Angel"}},
+		{"keywords must all match", Filter{Keywords: []string{"Flying", "Trample"}}, []string{}},
+		{"types", Filter{Types: []string{"legendary", "creature"}, Sets: []string{"RNA"}}, []string{"Teysa Karlov"}},
+		{"subtypes", Filter{Types: []string{"Angel"}}, []string{"Serra Angel"}},
+		{"oracle text", Filter{Text: []string{"triggers an additional time"}}, []string{"Teysa Karlov"}},
+		{"back face text", Filter{Text: []string{"draws X cards"}}, []string{"Expansion // Explosion"}},
+		{"cmc range", Filter{CMC: []Comparison{{">=", 4}, {"<", 5}}}, []string{"Teysa Karlov"}},
+		{"power", Filter{Power: []Comparison{{">", 2}}}, []string{"Bonecrusher Giant // Stomp", "Bushi Tenderfoot // Kenzo the Hardhearted", "Delver of Secrets // Insectile Aberration", "Serra Angel"}},
+		{"toughness skips cards without it", Filter{Toughness: []Comparison{{"<=", 4}}}, []string{"Bonecrusher Giant // Stomp", "Bushi Tenderfoot // Kenzo the Hardhearted", "Delver of Secrets // Insectile Aberration", "Serra Angel", "Teysa Karlov"}},
+		{"rarity", Filter{Rarities: []string{"RARE"}}, []string{"Bonecrusher Giant // Stomp", "Expansion // Explosion", "Teysa Karlov"}},
+		{"set", Filter{Sets: []string{"lea"}}, []string{"Serra Angel", "Swamp"}},
+		{"combined", Filter{Sets: []string{"LEA"}, Types: []string{"Creature"}}, []string{"Serra Angel"}},
+		{"limit counts cards, not faces", Filter{Limit: 2}, []string{"Bonecrusher Giant // Stomp", "Bushi Tenderfoot // Kenzo the Hardhearted"}},
+		{"offset", Filter{Limit: 2, Offset: 2}, []string{"Delver of Secrets // Insectile Aberration", "Expansion // Explosion"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, names(t, c.filter))
+		})
+	}
+
+	t.Run("test returns full cards", func(t *testing.T) {
+		found, err := repo.Search(Filter{Name: "Teysa"})
+		assert.NoError(t, err)
+		assert.Len(t, found, 1)
+		assert.Equal(t, "{2}{W}{B}", found[0].ManaCost)
+		assert.Equal(t, "2", found[0].Power)
+		assert.NotEmpty(t, found[0].Text)
+	})
+
+	t.Run("test invalid filters", func(t *testing.T) {
+		_, err := repo.Search(Filter{Colors: &ColorFilter{Colors: []string{"purple"}}})
+		assert.Error(t, err)
+		_, err = repo.Search(Filter{CMC: []Comparison{{"~", 1}}})
+		assert.Error(t, err)
+	})
+
+	t.Run("test is empty", func(t *testing.T) {
+		assert.True(t, Filter{}.IsEmpty())
+		assert.True(t, Filter{Limit: 10}.IsEmpty())
+		assert.False(t, Filter{Colors: &ColorFilter{}}.IsEmpty())
+	})
+}
+
+func TestSearchFullText(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+	if !repo.hasFTS() {
+		t.Skip("built without FTS5; run with -tags sqlite_fts5")
+	}
+
+	_, err := db.Exec(`INSERT INTO "cards" ("uuid", "name", "type", "text")
+		VALUES ('test-snow-swamp', 'Snow-Covered Swamp', 'Basic Snow Land — Swamp', '({T}: Add {B}.)')`)
+	assert.NoError(t, err)
+	assert.NoError(t, BuildIndex(db))
+
+	names := func(t *testing.T, f Filter) []string {
+		found, err := repo.Search(f)
+		assert.NoError(t, err)
+		out := []string{}
+		for _, c := range found {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+
+	t.Run("test ranks closer name matches first", func(t *testing.T) {
+		assert.Equal(t, []string{"Swamp", "Swamp", "Snow-Covered Swamp"}, names(t, Filter{Name: "swamp"}))
+	})
+
+	t.Run("test ranks name matches above type matches", func(t *testing.T) {
+		assert.Equal(t, []string{"Serra Angel"}, names(t, Filter{Query: "angel"}))
+		assert.Equal(t, []string{"Swamp", "Swamp", "Snow-Covered Swamp"}, names(t, Filter{Query: "swamp"}))
+	})
+
+	t.Run("test ignores accents", func(t *testing.T) {
+		assert.Equal(t, []string{"Lim-Dûl's Vault"}, names(t, Filter{Name: "lim dul", Sets: []string{"ALL"}}))
+	})
+
+	t.Run("test matches word prefixes", func(t *testing.T) {
+		assert.Equal(t, []string{"Teysa Karlov"}, names(t, Filter{Name: "tey kar"}))
+		assert.Equal(t, []string{}, names(t, Filter{Name: "arlov"}))
+	})
+
+	t.Run("test combines with other filters", func(t *testing.T) {
+		assert.Equal(t, []string{"Snow-Covered Swamp"}, names(t, Filter{Name: "swamp", Types: []string{"snow"}}))
+		assert.Equal(t, []string{}, names(t, Filter{Name: "%"}))
+	})
+}
+
+// BenchmarkSearch compares a LIKE scan of the cards table to the full-text
+// index over a card database about the size of AllPrintings.
+func BenchmarkSearch(b *testing.B) {
+	db, cleanup := newTestDB(b)
+	defer cleanup()
+
+	tx, err := db.Begin()
+	if err != nil {
+		b.Fatal(err)
+	}
+	stmt, err := tx.Prepare(`INSERT INTO "cards" ("uuid", "name", "type", "text") VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		b.Fatal(err)
+	}
+	adjectives := []string{"Ancient", "Blazing", "Cunning", "Dread", "Eternal", "Feral", "Gilded", "Hollow"}
+	nouns := []string{"Angel", "Behemoth", "Cultist", "Drake", "Elemental", "Familiar", "Golem", "Hydra"}
+	for i := 0; i < 60000; i++ {
+		name := fmt.Sprintf("%s %s %d", adjectives[i%len(adjectives)], nouns[(i/len(adjectives))%len(nouns)], i)
+		_, err := stmt.Exec(fmt.Sprintf("bench-%d", i), name, "Creature — "+nouns[i%len(nouns)],
+			"When this creature enters the battlefield, draw a card.")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	_ = stmt.Close()
+	if err := tx.Commit(); err != nil {
+		b.Fatal(err)
+	}
+
+	repo := NewRepository(db)
+	if err := BuildIndex(db); err != nil && !ErrNoFTS.Has(err) {
+		b.Fatal(err)
+	}
+	f := Filter{Name: "serra angel"}
+
+	b.Run("like", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.search(f, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("fts", func(b *testing.B) {
+		if !repo.hasFTS() {
+			b.Skip("built without FTS5; run with -tags sqlite_fts5")
+		}
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.search(f, true); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package cards
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// tokenOrder orders the printings of a token newest first, like
+// defaultOrder does for cards.
+const tokenOrder = ` ORDER BY (SELECT "releaseDate" FROM "sets" WHERE "sets"."code" = "tokens"."setCode") DESC, "id"`
+
+// Tokens returns every printing of the tokens with the given name, ignoring
+// case, newest first. Different tokens can share a name, like a white 1/1
+// flying Spirit and a colorless 1/1 Spirit, so callers pick between them.
+func (r *Repository) Tokens(name string) ([]Card, error) {
+	if name == "" {
+		return nil, errs.New("must provide name for token")
+	}
+	rows, err := r.scanAll(`SELECT `+columns+` FROM "tokens"
+		WHERE "name" = ? COLLATE NOCASE OR "faceName" = ? COLLATE NOCASE`+tokenOrder, name, name)
+	if err != nil {
+		return nil, err
+	}
+	return r.joinFaces("tokens", rows)
+}
+
+// TokenByUUID returns the token with the given MTGJSON uuid.
+func (r *Repository) TokenByUUID(uuid string) (Card, error) {
+	rows, err := r.scanAll(`SELECT `+columns+` FROM "tokens" WHERE "uuid" = ?`, uuid)
+	if err != nil {
+		return Card{}, err
+	}
+	found, err := r.joinFaces("tokens", rows)
+	if err != nil {
+		return Card{}, err
+	}
+	if len(found) == 0 {
+		return Card{}, ErrPrintingNotFound.New("no token %s", uuid)
+	}
+	return found[0], nil
+}
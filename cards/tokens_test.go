@@ -0,0 +1,36 @@
+package cards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokens(t *testing.T) {
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+	repo := NewRepository(db)
+
+	tokens, err := repo.Tokens("spirit")
+	assert.NoError(t, err)
+	if assert.Len(t, tokens, 1) {
+		spirit := tokens[0]
+		assert.Equal(t, "Spirit", spirit.Name)
+		assert.Equal(t, "token", spirit.Layout)
+		assert.Equal(t, []string{"W"}, spirit.Colors)
+		assert.Equal(t, "1", spirit.Power)
+		assert.Equal(t, []string{"Flying"}, spirit.Keywords)
+	}
+
+	tokens, err = repo.Tokens("Teysa Karlov")
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	treasure, err := repo.TokenByUUID("0a0b1c2d-0000-4000-8000-000000000102")
+	assert.NoError(t, err)
+	assert.Equal(t, "Treasure", treasure.Name)
+	assert.Equal(t, []string{"Artifact"}, treasure.Types)
+
+	_, err = repo.TokenByUUID("0a0b1c2d-0000-4000-8000-000000000001")
+	assert.True(t, ErrPrintingNotFound.Has(err))
+}
@@ -4,9 +4,41 @@ export const boardstates = gql`
 query($gameID: String!) {
   boardstates(gameID: $gameID) {
     Life
+    CommanderDamage {
+      Commander
+      Name
+      Amount
+    }
+    Emblems {
+      Name
+      Value
+      Player {
+        Username
+      }
+    }
     Commander {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -24,6 +56,26 @@ query($gameID: String!) {
     Library {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -41,6 +93,26 @@ query($gameID: String!) {
     Graveyard {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -58,6 +130,26 @@ query($gameID: String!) {
     Exiled {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -75,8 +167,30 @@ query($gameID: String!) {
     Field {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Tapped
       Flipped
+      FaceDown
+      Viewers
       Colors 
       ColorIdentity 
       ManaCost 
@@ -94,6 +208,26 @@ query($gameID: String!) {
     Hand {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -111,6 +245,26 @@ query($gameID: String!) {
     Revealed {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -128,8 +282,30 @@ query($gameID: String!) {
     Controlled {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Tapped
       Flipped
+      FaceDown
+      Viewers
       Colors 
       ColorIdentity 
       ManaCost 
@@ -156,11 +332,45 @@ export const updateBoardStateQuery = gql`
       }
       GameID
       Life
+      CommanderDamage {
+        Commander
+        Name
+        Amount
+      }
+      Emblems {
+        Name
+        Value
+        Player {
+          Username
+        }
+      }
       Commander { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Tapped
         Flipped
+        FaceDown
+        Viewers
         Colors 
         ColorIdentity 
         ManaCost 
@@ -178,6 +388,26 @@ export const updateBoardStateQuery = gql`
       Library { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -195,6 +425,26 @@ export const updateBoardStateQuery = gql`
       Graveyard { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -212,6 +462,26 @@ export const updateBoardStateQuery = gql`
       Exiled { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -229,8 +499,30 @@ export const updateBoardStateQuery = gql`
       Field { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Tapped
         Flipped
+        FaceDown
+        Viewers
         Colors 
         ColorIdentity 
         ManaCost 
@@ -248,6 +540,26 @@ export const updateBoardStateQuery = gql`
       Hand { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -265,6 +577,26 @@ export const updateBoardStateQuery = gql`
       Revealed { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -282,8 +614,30 @@ export const updateBoardStateQuery = gql`
       Controlled { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Tapped
         Flipped
+        FaceDown
+        Viewers
         Colors 
         ColorIdentity 
         ManaCost 
@@ -309,9 +663,41 @@ subscription($boardstate: InputBoardState!) {
       Username
     }
     Life
+    CommanderDamage {
+      Commander
+      Name
+      Amount
+    }
+    Emblems {
+      Name
+      Value
+      Player {
+        Username
+      }
+    }
     Commander {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -329,6 +715,26 @@ subscription($boardstate: InputBoardState!) {
     Library {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -346,6 +752,26 @@ subscription($boardstate: InputBoardState!) {
     Graveyard {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -363,6 +789,26 @@ subscription($boardstate: InputBoardState!) {
     Exiled {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -380,8 +826,30 @@ subscription($boardstate: InputBoardState!) {
     Field {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Tapped
       Flipped
+      FaceDown
+      Viewers
       Colors 
       ColorIdentity 
       ManaCost 
@@ -399,6 +867,26 @@ subscription($boardstate: InputBoardState!) {
     Hand {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -416,6 +904,26 @@ subscription($boardstate: InputBoardState!) {
     Revealed {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Colors 
       ColorIdentity 
       ManaCost 
@@ -433,8 +941,30 @@ subscription($boardstate: InputBoardState!) {
     Controlled {
       Name 
       ID 
+      InstanceID
+      AttachedTo
+      IsToken
+      Owner
+      Controller
+      IsCommander
+      Attacking
+      Blocking
+      Damage
+      LethalDamage
+      Counters {
+        Name
+        Value
+        AssignedBy
+      }
+      Labels {
+        Name
+        Value
+        AssignedBy
+      }
       Tapped
       Flipped
+      FaceDown
+      Viewers
       Colors 
       ColorIdentity 
       ManaCost 
@@ -455,14 +985,46 @@ subscription($boardstate: InputBoardState!) {
 
 export const selfStateQuery = gql`
   query($gameID: String!, $userID: String) {
-    boardstates(gameID: $gameID, userID: $userID) {
+    boardstates(gameID: $gameID, userID: $userID, viewer: $userID) {
       User {
         Username
       }
       Life
+      CommanderDamage {
+        Commander
+        Name
+        Amount
+      }
+      Emblems {
+        Name
+        Value
+        Player {
+          Username
+        }
+      }
       Commander { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -480,6 +1042,26 @@ export const selfStateQuery = gql`
       Library { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -497,6 +1079,26 @@ export const selfStateQuery = gql`
       Graveyard { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -514,6 +1116,26 @@ export const selfStateQuery = gql`
       Exiled { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -531,8 +1153,30 @@ export const selfStateQuery = gql`
       Field { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Tapped
         Flipped
+        FaceDown
+        Viewers
         Colors 
         ColorIdentity 
         ManaCost 
@@ -550,6 +1194,26 @@ export const selfStateQuery = gql`
       Hand { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -567,8 +1231,30 @@ export const selfStateQuery = gql`
       Revealed { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Tapped
         Flipped
+        FaceDown
+        Viewers
         Colors 
         ColorIdentity 
         ManaCost 
@@ -586,6 +1272,26 @@ export const selfStateQuery = gql`
       Controlled { 
         Name 
         ID 
+        InstanceID
+        AttachedTo
+        IsToken
+        Owner
+        Controller
+        IsCommander
+        Attacking
+        Blocking
+        Damage
+        LethalDamage
+        Counters {
+          Name
+          Value
+          AssignedBy
+        }
+        Labels {
+          Name
+          Value
+          AssignedBy
+        }
         Colors 
         ColorIdentity 
         ManaCost 
@@ -618,6 +1324,34 @@ subscription($game: InputGame!) {
       Username
       ID
     }
+    Stack {
+      ID
+      Kind
+      Controller
+      Description
+      Targets
+      Source
+      Card {
+        Name
+        InstanceID
+        Owner
+      }
+    }
+    Priority
+    Passed
+    Seats {
+      Position
+      Username
+      Eliminated
+    }
+    Reversed
+    ExtraTurns
+    SpellsCast
+    Designations {
+      Name
+      Player
+      Value
+    }
   }
 } 
 `
\ No newline at end of file
@@ -1,10 +1,14 @@
 package game
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
 	"log"
-	"math/rand"
+	"strconv"
 	"strings"
 
+	"github.com/dylanlott/edh-go/cards"
 	"github.com/dylanlott/edh-go/persistence"
 	"github.com/zeebo/errs"
 )
@@ -13,8 +17,13 @@ import (
 // own state and their own interactions, since they're the atomic unit of
 // Magic.
 type Card struct {
-	Name  string
-	Split string // For handling split cards
+	Name string
+
+	// Layout and Faces come from the card database. Faces is empty for
+	// cards with one face, and Face is the index of the face showing.
+	Layout string
+	Faces  []cards.Face
+	Face   int
 
 	// Data gets populated by database queries
 	Data CardData
@@ -65,76 +74,162 @@ func NewDecklist(db persistence.Database, raw string) (CardList, []error) {
 	return decklist, errors
 }
 
-// Query will try to find card info for Card.Name
+// Query will try to find card info for Card.Name. If id is given it selects
+// a specific printing, either by its MTGJSON uuid or its card database id;
+// otherwise the default printing is returned.
 func Query(db persistence.Database, name string, id *string) (Card, error) {
-	if name == "" {
-		return Card{}, errs.New("must provide name for card")
+	repo := cards.NewRepository(db)
+	if id == nil || *id == "" {
+		printing, err := repo.Printing(name, cards.Printing{})
+		if err != nil {
+			return Card{}, errs.Wrap(err)
+		}
+		return FromCard(printing), nil
 	}
 
-	rows, err := db.Query(`SELECT "id", "name", "colors", "colorIdentity",
-		"convertedManaCost", "manaCost", "uuid", "power", "toughness", "types",
-		"subtypes", "supertypes", "isTextless", "text", "tcgplayerProductId"
-		FROM "cards" WHERE "name" = ?`, name)
-	if err != nil {
-		return Card{}, errs.New("failed to run query: %s", err)
-	}
-
-	cards := []Card{}
-
-	for rows.Next() {
-		var (
-			id                 *int
-			name               *string
-			colors             *string
-			colorIdentity      *string
-			convertedManaCost  *string
-			manaCost           *string
-			uuid               *string
-			power              *string
-			toughness          *string
-			types              *string
-			subtypes           *string
-			supertypes         *string
-			isTextless         *int
-			text               *string
-			tcgplayerProductId *int
-		)
-
-		if err := rows.Scan(&id, &name, &colors, &colorIdentity,
-			&convertedManaCost, &manaCost, &uuid, &power, &toughness, &types,
-			&subtypes, &supertypes, &isTextless, &text,
-			&tcgplayerProductId); err != nil {
-			log.Printf("error scanning rows for card query: %s", err)
-			continue
+	if _, err := strconv.Atoi(*id); err == nil {
+		printings, err := repo.ByName(name)
+		if err != nil {
+			return Card{}, errs.Wrap(err)
 		}
-
-		// Add the data to a map for returning
-		data := make(CardData)
-		data["name"] = *name
-		data["id"] = *id
-		data["colors"] = *colors
-		data["colorIdentity"] = *colorIdentity
-		data["convertedManaCost"] = *convertedManaCost
-		data["manaCost"] = *manaCost
-
-		card := Card{
-			Name: *name,
-			Data: data,
+		for _, p := range printings {
+			if strconv.Itoa(p.ID) == *id {
+				return FromCard(p), nil
+			}
 		}
+		return Card{}, cards.ErrPrintingNotFound.New("%s has no printing %s", name, *id)
+	}
+
+	printing, err := repo.Printing(name, cards.Printing{UUID: *id})
+	if err != nil {
+		return Card{}, errs.Wrap(err)
+	}
+	return FromCard(printing), nil
+}
+
+// FromCard creates a game Card from a card database printing. Data is keyed
+// by the card database's column names.
+func FromCard(c cards.Card) Card {
+	data := make(CardData)
+	data["id"] = c.ID
+	data["uuid"] = c.UUID
+	data["name"] = c.Name
+	data["layout"] = c.Layout
+	data["setCode"] = c.SetCode
+	data["number"] = c.Number
+	data["rarity"] = c.Rarity
+	data["colors"] = strings.Join(c.Colors, ",")
+	data["colorIdentity"] = strings.Join(c.ColorIdentity, ",")
+	data["convertedManaCost"] = c.CMCString()
+	data["manaCost"] = c.ManaCost
+	data["power"] = c.Power
+	data["toughness"] = c.Toughness
+	data["loyalty"] = c.Loyalty
+	data["type"] = c.TypeLine
+	data["subtypes"] = strings.Join(c.Subtypes, ",")
+	data["supertypes"] = strings.Join(c.Supertypes, ",")
+	data["keywords"] = strings.Join(c.Keywords, ",")
+	data["text"] = c.Text
+	data["scryfallId"] = c.ScryfallID
+	data["scryfallIllustrationId"] = c.ScryfallIllustrationID
+
+	return Card{
+		Name:      c.Name,
+		Layout:    c.Layout,
+		Faces:     c.Faces,
+		Data:      data,
+		CardTypes: c.Types,
+	}
+}
+
+// ErrCantTransform is returned when a card has no other face to turn to.
+var ErrCantTransform = errs.Class("can't transform")
 
-		cards = append(cards, card)
+// Transform turns a double-faced card over, or flips a flip card, to show
+// its next face. Split and adventure cards can't be transformed since their
+// face is chosen when they're cast.
+func (c *Card) Transform() error {
+	next, ok := cards.NextFace(c.Layout, len(c.Faces), c.Face)
+	if !ok {
+		return ErrCantTransform.New("%s", c.Name)
 	}
-	// TODO: return card with given id if *id is passed to args
+	c.Face = next
+	c.CardTypes = c.Faces[c.Face].Types
+	return nil
+}
 
-	return cards[0], err
+// Showing returns the face of the card that's showing, and false if the
+// card only has one face.
+func (c Card) Showing() (cards.Face, bool) {
+	if len(c.Faces) == 0 {
+		return cards.Face{}, false
+	}
+	return c.Faces[c.Face], true
 }
 
-// Shuffle is a sugar method to make Shuffling a list of Cards easier.
+// Shuffle is a sugar method to make Shuffling a list of Cards easier. It
+// shuffles with a seed read from crypto/rand.
 func Shuffle(deck CardList) (CardList, error) {
-	rand.Shuffle(len(deck), func(i, j int) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return deck, errs.Wrap(err)
+	}
+	return ShuffleSeeded(deck, seed), nil
+}
+
+// ShuffleSeeded shuffles a list of Cards in place so that the same list and
+// seed always give the same order. It's the shuffle the server uses too, so
+// a game's revealed seeds can be replayed with either.
+func ShuffleSeeded(deck CardList, seed []byte) CardList {
+	SeededShuffle(len(deck), seed, func(i, j int) {
 		deck[i], deck[j] = deck[j], deck[i]
 	})
-	return deck, nil
+	return deck
+}
+
+// SeededShuffle applies a Fisher-Yates shuffle to n items with swap, like
+// rand.Shuffle, so that the same n and seed always give the same order.
+// Going from the last item down, item i is swapped with item j, where j is
+// drawn uniformly from [0, i] by taking the stream of the seed's counter
+// mode SHA-256 hashes eight bytes at a time as big endian uint64s, and
+// rejecting values that would bias the draw.
+func SeededShuffle(n int, seed []byte, swap func(i, j int)) {
+	stream := &seedStream{seed: seed}
+	for i := n - 1; i > 0; i-- {
+		swap(i, int(stream.intn(uint64(i+1))))
+	}
+}
+
+// seedStream reads uint64s from SHA-256(seed || counter), for counter
+// 0, 1, 2, and so on as big endian uint64s.
+type seedStream struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func (s *seedStream) uint64() uint64 {
+	if len(s.buf) < 8 {
+		h := sha256.New()
+		_, _ = h.Write(s.seed)
+		_ = binary.Write(h, binary.BigEndian, s.counter)
+		s.counter++
+		s.buf = h.Sum(nil)
+	}
+	v := binary.BigEndian.Uint64(s.buf[:8])
+	s.buf = s.buf[8:]
+	return v
+}
+
+// intn returns a uniform draw from [0, n).
+func (s *seedStream) intn(n uint64) uint64 {
+	// the largest multiple of n that fits, so every value is equally likely
+	max := ^uint64(0) - ^uint64(0)%n
+	for {
+		if v := s.uint64(); v < max {
+			return v % n
+		}
+	}
 }
 
 // Validate will valiate the Deck against the format specified in args.
@@ -160,11 +255,12 @@ func Fetch(card Card, list CardList) (Card, CardList, error) {
 	found := false
 	fetched := Card{}
 
-	for _, c := range list {
+	for i, c := range list {
 		if card.Name == c.Name {
 			found = true
 			fetched = c
-			// TODO: Remove at index from slice
+			// copy the rest of the list so the caller's isn't changed
+			list = append(list[:i:i], list[i+1:]...)
 			break
 		}
 	}
@@ -234,6 +330,83 @@ func Put(deck CardList, cards CardList, pos int, shuffle bool) (library CardList
 	return library, nil
 }
 
+// Mill returns the top `number` cards of the deck, and the library with the
+// cards removed from it. Milling more cards than the deck has mills all of
+// them.
+func Mill(deck CardList, number int) (milled CardList, library CardList) {
+	if number > len(deck) {
+		number = len(deck)
+	}
+	if number < 0 {
+		number = 0
+	}
+	return deck[:number], deck[number:]
+}
+
+// Arrange puts the top len(top)+len(bottom) cards of the deck back in the
+// order a player chose, as when they scry. top and bottom hold indexes into
+// those cards, where 0 is the top card of the deck. The cards in top are put
+// back on top and the cards in bottom on the bottom, in the order they're
+// listed from the top down. Every one of the cards must be listed once.
+func Arrange(deck CardList, top []int, bottom []int) (library CardList, err error) {
+	kept, moved, rest, err := split(deck, top, bottom)
+	if err != nil {
+		return nil, err
+	}
+	library = CardList{}
+	library = append(library, kept...)
+	library = append(library, rest...)
+	library = append(library, moved...)
+	return library, nil
+}
+
+// Surveil puts the top len(top)+len(graveyard) cards of the deck back on top
+// or into the graveyard. Like Arrange, top and graveyard hold indexes into
+// those cards, and the cards kept on top are put back in the order listed.
+func Surveil(deck CardList, top []int, graveyard []int) (library CardList, milled CardList, err error) {
+	kept, milled, rest, err := split(deck, top, graveyard)
+	if err != nil {
+		return nil, nil, err
+	}
+	library = CardList{}
+	library = append(library, kept...)
+	library = append(library, rest...)
+	return library, milled, nil
+}
+
+// split divides the top len(a)+len(b) cards of the deck by the indexes in a
+// and b, and returns the rest of the deck.
+func split(deck CardList, a []int, b []int) (CardList, CardList, CardList, error) {
+	n := len(a) + len(b)
+	if n > len(deck) {
+		return nil, nil, nil, errs.New("can't look at %d cards of a %d card deck", n, len(deck))
+	}
+	seen := make(map[int]bool, n)
+	pick := func(indexes []int) (CardList, error) {
+		out := CardList{}
+		for _, i := range indexes {
+			if i < 0 || i >= n {
+				return nil, errs.New("card %d is not one of the top %d", i, n)
+			}
+			if seen[i] {
+				return nil, errs.New("card %d is listed more than once", i)
+			}
+			seen[i] = true
+			out = append(out, deck[i])
+		}
+		return out, nil
+	}
+	first, err := pick(a)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	second, err := pick(b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return first, second, deck[n:], nil
+}
+
 // getCard returns a single Card from the Database layer, or an error.
 // If the card does not exist, an error will be thrown and Card{} will be
 // returned. This is safe to run asynchronously.
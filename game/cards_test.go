@@ -1,8 +1,13 @@
 package game
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
+	"github.com/dylanlott/edh-go/cards"
 	"github.com/dylanlott/edh-go/persistence"
 	"github.com/stretchr/testify/assert"
 )
@@ -95,6 +100,10 @@ func makeDeck(t *testing.T) CardList {
 			},
 		}
 	} else {
+		// NB: sqlite3 creates missing files on open, so check for it first.
+		if _, err := os.Stat("../persistence/mtgallcards.sqlite"); err != nil {
+			t.Skipf("no card database found - skipping database tests")
+		}
 		db, err := persistence.NewSQLite("../persistence/mtgallcards.sqlite")
 		if err != nil {
 			t.Skipf("unable to connect to db - skipping database tests")
@@ -107,11 +116,174 @@ func makeDeck(t *testing.T) CardList {
 	}
 }
 
-// TODO: Make test cases handle dual cards with `//` in the name
-// E.g. Expansion / Explosion, Insult / Injury, etc...
-
 const testdata = `Warlord's Fury
 Teysa, Envoy of Ghosts
 Shock
 Karlov of the Ghost Council
 `
+
+func TestFromCard(t *testing.T) {
+	card := FromCard(cards.Card{
+		ID:       1,
+		Name:     "Shock",
+		Colors:   []string{"R"},
+		CMC:      1,
+		ManaCost: "{R}",
+		Types:    []string{"Instant"},
+	})
+	assert.Equal(t, "Shock", card.Name)
+	assert.Equal(t, []string{"Instant"}, card.CardTypes)
+	assert.Equal(t, 1, card.Data["id"])
+	assert.Equal(t, "R", card.Data["colors"])
+	assert.Equal(t, "1", card.Data["convertedManaCost"])
+	assert.Equal(t, "{R}", card.Data["manaCost"])
+}
+
+func TestTransform(t *testing.T) {
+	delver := FromCard(cards.Card{
+		Name:   "Delver of Secrets // Insectile Aberration",
+		Layout: "transform",
+		Types:  []string{"Creature"},
+		Faces: []cards.Face{
+			{Name: "Delver of Secrets", Power: "1", Types: []string{"Creature"}},
+			{Name: "Insectile Aberration", Power: "3", Types: []string{"Creature"}},
+		},
+	})
+	face, ok := delver.Showing()
+	assert.True(t, ok)
+	assert.Equal(t, "Delver of Secrets", face.Name)
+
+	assert.NoError(t, delver.Transform())
+	face, _ = delver.Showing()
+	assert.Equal(t, "Insectile Aberration", face.Name)
+	assert.Equal(t, "3", face.Power)
+
+	assert.NoError(t, delver.Transform())
+	face, _ = delver.Showing()
+	assert.Equal(t, "Delver of Secrets", face.Name)
+
+	split := FromCard(cards.Card{
+		Name:   "Expansion // Explosion",
+		Layout: "split",
+		Faces:  []cards.Face{{Name: "Expansion"}, {Name: "Explosion"}},
+	})
+	assert.True(t, ErrCantTransform.Has(split.Transform()))
+
+	shock := FromCard(cards.Card{Name: "Shock", Layout: "normal"})
+	assert.True(t, ErrCantTransform.Has(shock.Transform()))
+	_, ok = shock.Showing()
+	assert.False(t, ok)
+}
+
+func TestQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edhgo-game")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := persistence.NewSQLite(filepath.Join(dir, "cards.sqlite"))
+	assert.NoError(t, err)
+	f, err := cards.OpenImportFile("../cards/testdata/AllPrintings.json")
+	assert.NoError(t, err)
+	defer f.Close()
+	_, err = cards.Import(db, f)
+	assert.NoError(t, err)
+
+	card, err := Query(db, "Swamp", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "RNA", card.Data["setCode"])
+
+	uuid := "0a0b1c2d-0000-4000-8000-000000000004"
+	card, err = Query(db, "Swamp", &uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, "LEA", card.Data["setCode"])
+
+	id := strconv.Itoa(card.Data["id"].(int))
+	card, err = Query(db, "Swamp", &id)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid, card.Data["uuid"])
+
+	missing := "0"
+	_, err = Query(db, "Swamp", &missing)
+	assert.True(t, cards.ErrPrintingNotFound.Has(err))
+
+	_, err = Query(db, "Not A Card", nil)
+	assert.Error(t, err)
+}
+
+func TestLibrary(t *testing.T) {
+	names := func(list CardList) []string {
+		out := []string{}
+		for _, c := range list {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+	deck := func() CardList {
+		return CardList{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	}
+
+	t.Run("test fetch removes the card", func(t *testing.T) {
+		list := deck()
+		card, library, err := Fetch(Card{Name: "c"}, list)
+		assert.NoError(t, err)
+		assert.Equal(t, "c", card.Name)
+		assert.Len(t, library, 4)
+		assert.NotContains(t, names(library), "c")
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names(list))
+
+		_, library, err = Fetch(Card{Name: "z"}, deck())
+		assert.Error(t, err)
+		assert.Len(t, library, 5)
+	})
+
+	t.Run("test mill", func(t *testing.T) {
+		milled, library := Mill(deck(), 2)
+		assert.Equal(t, []string{"a", "b"}, names(milled))
+		assert.Equal(t, []string{"c", "d", "e"}, names(library))
+
+		milled, library = Mill(deck(), 10)
+		assert.Len(t, milled, 5)
+		assert.Len(t, library, 0)
+	})
+
+	t.Run("test arrange", func(t *testing.T) {
+		library, err := Arrange(deck(), []int{2, 0}, []int{1})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"c", "a", "d", "e", "b"}, names(library))
+
+		_, err = Arrange(deck(), []int{0, 0}, nil)
+		assert.Error(t, err)
+		_, err = Arrange(deck(), []int{0}, []int{2})
+		assert.Error(t, err)
+		_, err = Arrange(deck(), []int{0, 1, 2}, []int{3, 4, 5})
+		assert.Error(t, err)
+	})
+
+	t.Run("test surveil", func(t *testing.T) {
+		library, milled, err := Surveil(deck(), []int{1}, []int{0})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b", "c", "d", "e"}, names(library))
+		assert.Equal(t, []string{"a"}, names(milled))
+	})
+}
+
+func TestShuffleSeeded(t *testing.T) {
+	deck := func() CardList {
+		return CardList{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}, {Name: "f"}}
+	}
+	names := func(list CardList) string {
+		out := ""
+		for _, c := range list {
+			out += c.Name
+		}
+		return out
+	}
+
+	// the server's ShuffleSeeded test uses the same seed and order
+	assert.Equal(t, "bacfde", names(ShuffleSeeded(deck(), []byte("edh-go"))))
+	assert.Equal(t, names(ShuffleSeeded(deck(), []byte("seed"))), names(ShuffleSeeded(deck(), []byte("seed"))))
+
+	shuffled, err := Shuffle(deck())
+	assert.NoError(t, err)
+	assert.Len(t, shuffled, 6)
+}
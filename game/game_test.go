@@ -8,8 +8,7 @@ import (
 	"github.com/dylanlott/edh-go/persistence"
 )
 
-// TestNewFullGame tries to start a redis instance and uses it to run an
-// integration test suite.
+// TestNewFullGame uses an in-memory KV to run an integration test suite.
 func TestNewFullGame(t *testing.T) {
 	players := make(map[UserID]Deck)
 	players["player1"] = Deck{
@@ -20,14 +19,13 @@ func TestNewFullGame(t *testing.T) {
 		Cards: TestDeck,
 	}
 
-	db, err := persistence.NewRedis(persistence.Config{})
-	assert.NoError(t, err)
+	db := persistence.NewMemory()
 	assert.NotNil(t, db)
+	assert.NoError(t, db.Ping())
 }
 
 func TestBoardState(t *testing.T) {
-	db, err := persistence.NewRedis(persistence.Config{})
-	assert.NoError(t, err)
+	db := persistence.NewMemory()
 
 	players := make(map[UserID]Deck)
 	players["player1"] = Deck{
@@ -83,7 +83,20 @@ func (p *PlayerState) Fetch(card Card)                        {}
 func (p *PlayerState) AddToLibrary(card Card, pos int)        {}
 func (p *PlayerState) AddToGraveyard(card Card)               {}
 func (p *PlayerState) AddToExile(card Card)                   {}
-func (p *PlayerState) Scry(num int)                           {}
+
+// Scry puts the top len(top)+len(bottom) cards of the player's library back
+// on the top or bottom in the order they chose. See Arrange.
+func (p *PlayerState) Scry(top []int, bottom []int) error {
+	p.Lock()
+	defer p.Unlock()
+
+	library, err := Arrange(p.BoardState.Library, top, bottom)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	p.BoardState.Library = library
+	return nil
+}
 
 // Move will move a single Card{} around from one list to another using Fetch.
 // TODO: This function will need to be fleshed out and tested
@@ -32,4 +32,11 @@ func TestPlayerBoardStates(t *testing.T) {
 
 	t.Run("test shuffle", func(t *testing.T) {
 	})
+
+	t.Run("test scry", func(t *testing.T) {
+		player.BoardState.Library = CardList{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+		assert.NoError(t, player.Scry([]int{1}, []int{0}))
+		assert.Equal(t, CardList{{Name: "b"}, {Name: "c"}, {Name: "a"}}, player.BoardState.Library)
+		assert.Error(t, player.Scry([]int{0}, []int{0}))
+	})
 }
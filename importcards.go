@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// runImportCards handles the `import-cards` subcommand, which builds or
+// refreshes the card database from an MTGJSON file.
+func runImportCards(args []string) (string, error) {
+	fs := flag.NewFlagSet("import-cards", flag.ContinueOnError)
+	dbPath := fs.String("db", "./persistence/AllPrintings.sqlite", "path of the card database to create or update")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: edhgo import-cards [-db path] <AllPrintings.json[.gz|.bz2|.zip]>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return "", errs.New("must provide an MTGJSON file to import")
+	}
+
+	db, err := persistence.NewSQLite(*dbPath)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := cards.OpenImportFile(fs.Arg(0))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	stats, err := cards.Import(db, f)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("imported %d cards and %d tokens from %d sets into %s", stats.Cards, stats.Tokens, stats.Sets, *dbPath), nil
+}
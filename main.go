@@ -3,16 +3,22 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/zeebo/errs"
 
+	"github.com/dylanlott/edh-go/cards"
 	"github.com/dylanlott/edh-go/persistence"
 	"github.com/dylanlott/edh-go/server"
 )
 
 type config struct {
 	RedisURL string `envconfig:"REDIS_URL"`
+
+	// KVBackend selects where game state is kept: "redis", "memory", or
+	// "sqlite". The sqlite backend stores it in the app database.
+	KVBackend string `envconfig:"KV_BACKEND" default:"redis"`
 }
 
 func main() {
@@ -22,17 +28,48 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "import-cards" {
+		out, err := runImportCards(os.Args[2:])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(out)
+		return
+	}
+
 	db, err := persistence.NewSQLite("./persistence/db.sqlite")
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		out, err := runMigrate(db, os.Args[2:])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(out)
+		return
+	}
+
+	err = persistence.Migrate(db, persistence.Migrations)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	kv, err := newKV(cfg, db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	cardDB, err := persistence.NewSQLite("./persistence/AllPrintings.sqlite")
 	if err != nil {
 		log.Fatalf(errs.Wrap(err).Error())
 	}
+	if err := cards.EnsureSchema(cardDB); err != nil {
+		log.Fatalf("failed to update card database: %s", err)
+	}
 
-	s, err := server.NewGraphQLServer(nil, db, cardDB)
+	s, err := server.NewGraphQLServer(kv, db, cardDB)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -45,3 +82,18 @@ func main() {
 	fmt.Printf("server listening on localhost:%d", 8080)
 	fmt.Printf("serving graphiql playground at localhost:8080/playground")
 }
+
+// newKV returns the KV store selected by the config.
+func newKV(cfg config, db *persistence.DB) (persistence.KV, error) {
+	switch cfg.KVBackend {
+	case "redis":
+		return persistence.NewRedis(persistence.Config{"addr": cfg.RedisURL})
+	case "memory":
+		log.Printf("using in-memory kv store; game state will not survive a restart")
+		return persistence.NewMemory(), nil
+	case "sqlite":
+		return persistence.NewSQLiteKV(db)
+	default:
+		return nil, errs.New("unknown KV_BACKEND %q", cfg.KVBackend)
+	}
+}
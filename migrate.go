@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+const migrateUsage = `usage: edhgo migrate <command>
+
+commands:
+  up [version]     apply migrations up to version, or the latest
+  down [version]   revert migrations down to version, or by one
+  version          print the current schema version`
+
+// runMigrate handles the `migrate` subcommand for the app database.
+func runMigrate(db *persistence.DB, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errs.New(migrateUsage)
+	}
+
+	current, err := persistence.SchemaVersion(db)
+	if err != nil {
+		return "", err
+	}
+
+	var target int
+	switch args[0] {
+	case "version":
+		return strconv.Itoa(current), nil
+	case "up":
+		target = len(persistence.Migrations)
+	case "down":
+		target = current - 1
+		if target < 0 {
+			target = 0
+		}
+	default:
+		return "", errs.New(migrateUsage)
+	}
+
+	if len(args) > 1 {
+		target, err = strconv.Atoi(args[1])
+		if err != nil {
+			return "", errs.New("invalid version %q", args[1])
+		}
+		if args[0] == "up" && target < current || args[0] == "down" && target > current {
+			return "", errs.New("can't migrate %s from version %d to %d", args[0], current, target)
+		}
+	}
+
+	if err := persistence.MigrateTo(db, persistence.Migrations, target); err != nil {
+		return "", err
+	}
+	return "schema at version " + strconv.Itoa(target), nil
+}
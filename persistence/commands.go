@@ -0,0 +1,183 @@
+package persistence
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// commandKV is a KV that can report key expiry, which is all doCommand needs
+// beyond the KV interface to support EXISTS and TTL.
+type commandKV interface {
+	KV
+	ttl(key Key) (ttl time.Duration, exists bool, err error)
+}
+
+// doCommand runs a Redis-style command against a KV that isn't Redis. Only
+// the commands the KV interface exposes, plus EXISTS and TTL, are supported.
+// Return types match what the Redis driver returns for each command.
+func doCommand(kv commandKV, cmd string, args ...interface{}) (interface{}, error) {
+	strs := make([]string, 0, len(args))
+	for _, a := range args {
+		strs = append(strs, fmt.Sprint(a))
+	}
+	arity := func(n int) error {
+		if len(strs) < n {
+			return errs.New("wrong number of arguments for %s", cmd)
+		}
+		return nil
+	}
+
+	switch strings.ToUpper(cmd) {
+	case "PING":
+		if err := kv.Ping(); err != nil {
+			return nil, err
+		}
+		return "PONG", nil
+	case "GET":
+		if err := arity(1); err != nil {
+			return nil, err
+		}
+		v, _, err := kv.Get(Key(strs[0]))
+		if err != nil {
+			return nil, err
+		}
+		return string(v), nil
+	case "SET":
+		if err := arity(2); err != nil {
+			return nil, err
+		}
+		if _, err := kv.Put(Key(strs[0]), Value(strs[1])); err != nil {
+			return nil, err
+		}
+		return "OK", nil
+	case "DEL":
+		if err := arity(1); err != nil {
+			return nil, err
+		}
+		return kv.Delete(toKeys(strs)...)
+	case "EXISTS":
+		if err := arity(1); err != nil {
+			return nil, err
+		}
+		var n int64
+		for _, k := range strs {
+			_, ok, err := kv.ttl(Key(k))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				n++
+			}
+		}
+		return n, nil
+	case "EXPIRE":
+		if err := arity(2); err != nil {
+			return nil, err
+		}
+		secs, err := strconv.ParseInt(strs[1], 10, 64)
+		if err != nil {
+			return nil, errs.New("value is not an integer: %s", strs[1])
+		}
+		ok, err := kv.Expire(Key(strs[0]), time.Duration(secs)*time.Second)
+		if err != nil || !ok {
+			return int64(0), err
+		}
+		return int64(1), nil
+	case "TTL":
+		if err := arity(1); err != nil {
+			return nil, err
+		}
+		ttl, ok, err := kv.ttl(Key(strs[0]))
+		switch {
+		case err != nil:
+			return nil, err
+		case !ok:
+			return int64(-2), nil
+		case ttl < 0:
+			return int64(-1), nil
+		default:
+			return int64(ttl.Round(time.Second) / time.Second), nil
+		}
+	case "LPUSH":
+		if err := arity(2); err != nil {
+			return nil, err
+		}
+		return kv.LPush(Key(strs[0]), toValues(strs[1:])...)
+	case "LRANGE":
+		if err := arity(3); err != nil {
+			return nil, err
+		}
+		start, err := strconv.ParseInt(strs[1], 10, 64)
+		if err != nil {
+			return nil, errs.New("value is not an integer: %s", strs[1])
+		}
+		stop, err := strconv.ParseInt(strs[2], 10, 64)
+		if err != nil {
+			return nil, errs.New("value is not an integer: %s", strs[2])
+		}
+		vals, err := kv.LRange(Key(strs[0]), start, stop)
+		return toInterfaces(vals), err
+	case "SADD":
+		if err := arity(2); err != nil {
+			return nil, err
+		}
+		return kv.SAdd(Key(strs[0]), toValues(strs[1:])...)
+	case "SMEMBERS":
+		if err := arity(1); err != nil {
+			return nil, err
+		}
+		vals, err := kv.SMembers(Key(strs[0]))
+		return toInterfaces(vals), err
+	case "PUBLISH":
+		if err := arity(2); err != nil {
+			return nil, err
+		}
+		return kv.Publish(strs[0], Value(strs[1]))
+	default:
+		return nil, errs.New("unsupported command: %s", cmd)
+	}
+}
+
+// listRange converts Redis-style inclusive start and stop indexes, which may
+// be negative, into a slice offset and count for a list of length n.
+func listRange(start, stop, n int64) (offset, count int64) {
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+	return start, stop - start + 1
+}
+
+func toKeys(strs []string) []Key {
+	out := make([]Key, 0, len(strs))
+	for _, s := range strs {
+		out = append(out, Key(s))
+	}
+	return out
+}
+
+func toInterfaces(vals []Value) []interface{} {
+	if vals == nil {
+		return nil
+	}
+	out := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		out = append(out, string(v))
+	}
+	return out
+}
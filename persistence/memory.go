@@ -0,0 +1,316 @@
+package persistence
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// itemKind tracks which Redis data type a key in memoryKV holds.
+type itemKind int
+
+const (
+	kindString itemKind = iota
+	kindList
+	kindSet
+)
+
+// memoryItem is a single key in memoryKV.
+type memoryItem struct {
+	kind    itemKind
+	str     Value
+	list    []Value
+	set     map[Value]struct{}
+	expires time.Time
+}
+
+// memoryKV is an in-process KV that mimics Redis semantics for strings,
+// lists, sets, key expiry, and pub/sub. It is intended for tests and
+// single-binary deployments where running Redis isn't worth it. Nothing is
+// persisted across restarts.
+type memoryKV struct {
+	mu    sync.Mutex
+	items map[string]*memoryItem
+	subs  map[string]map[*memorySubscription]struct{}
+
+	// now is swappable so that expiry can be tested without sleeping.
+	now func() time.Time
+}
+
+// Force memoryKV to fulfill KV
+var _ = (KV)(&memoryKV{})
+
+// NewMemory returns an empty in-memory KV.
+func NewMemory() *memoryKV {
+	return &memoryKV{
+		items: make(map[string]*memoryItem),
+		subs:  make(map[string]map[*memorySubscription]struct{}),
+		now:   time.Now,
+	}
+}
+
+// lookup returns the live item at key, evicting it if it has expired.
+// Callers must hold m.mu.
+func (m *memoryKV) lookup(key string) *memoryItem {
+	item, ok := m.items[key]
+	if !ok {
+		return nil
+	}
+	if !item.expires.IsZero() && !m.now().Before(item.expires) {
+		delete(m.items, key)
+		return nil
+	}
+	return item
+}
+
+// lookupKind returns the live item at key if it holds the given kind, nil if
+// the key doesn't exist, or ErrWrongType. Callers must hold m.mu.
+func (m *memoryKV) lookupKind(key string, kind itemKind) (*memoryItem, error) {
+	item := m.lookup(key)
+	if item == nil {
+		return nil, nil
+	}
+	if item.kind != kind {
+		return nil, ErrWrongType.New("%s", key)
+	}
+	return item, nil
+}
+
+// Put sets key to val. Like Redis SET, this overwrites a key of any type and
+// clears its expiry.
+func (m *memoryKV) Put(key Key, val Value) (Value, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.items[string(key)] = &memoryItem{kind: kindString, str: val}
+	return val, nil
+}
+
+// Get returns the value at key. A missing key returns ErrNotFound.
+func (m *memoryKV) Get(key Key) (Value, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, err := m.lookupKind(string(key), kindString)
+	if err != nil {
+		return Value(""), false, err
+	}
+	if item == nil {
+		return Value(""), false, ErrNotFound.New("%s", key)
+	}
+	return item.str, true, nil
+}
+
+// Delete removes keys and returns how many existed.
+func (m *memoryKV) Delete(keys ...Key) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var n int64
+	for _, k := range keys {
+		if m.lookup(string(k)) != nil {
+			delete(m.items, string(k))
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Expire sets a time to live on key. A ttl of zero or less deletes the key
+// immediately, like Redis.
+func (m *memoryKV) Expire(key Key, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := m.lookup(string(key))
+	if item == nil {
+		return false, nil
+	}
+	if ttl <= 0 {
+		delete(m.items, string(key))
+		return true, nil
+	}
+	item.expires = m.now().Add(ttl)
+	return true, nil
+}
+
+// LPush prepends vals to the list at key, one at a time, so the last value
+// given ends up at the head of the list.
+func (m *memoryKV) LPush(key Key, vals ...Value) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, err := m.lookupKind(string(key), kindList)
+	if err != nil {
+		return 0, err
+	}
+	if item == nil {
+		item = &memoryItem{kind: kindList}
+		m.items[string(key)] = item
+	}
+
+	list := make([]Value, 0, len(item.list)+len(vals))
+	for i := len(vals) - 1; i >= 0; i-- {
+		list = append(list, vals[i])
+	}
+	item.list = append(list, item.list...)
+
+	return int64(len(item.list)), nil
+}
+
+// LRange returns the elements of the list at key from start to stop inclusive.
+func (m *memoryKV) LRange(key Key, start, stop int64) ([]Value, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, err := m.lookupKind(string(key), kindList)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return []Value{}, nil
+	}
+
+	offset, count := listRange(start, stop, int64(len(item.list)))
+	out := make([]Value, count)
+	copy(out, item.list[offset:offset+count])
+	return out, nil
+}
+
+// SAdd adds members to the set at key and returns how many were new.
+func (m *memoryKV) SAdd(key Key, members ...Value) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, err := m.lookupKind(string(key), kindSet)
+	if err != nil {
+		return 0, err
+	}
+	if item == nil {
+		item = &memoryItem{kind: kindSet, set: make(map[Value]struct{})}
+		m.items[string(key)] = item
+	}
+
+	var added int64
+	for _, v := range members {
+		if _, ok := item.set[v]; !ok {
+			item.set[v] = struct{}{}
+			added++
+		}
+	}
+	return added, nil
+}
+
+// SMembers returns the members of the set at key. Redis makes no ordering
+// guarantee, but these are sorted to keep results stable.
+func (m *memoryKV) SMembers(key Key) ([]Value, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, err := m.lookupKind(string(key), kindSet)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return []Value{}, nil
+	}
+
+	out := make([]Value, 0, len(item.set))
+	for v := range item.set {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+// Publish delivers msg to every current subscriber of channel. Like Redis,
+// messages are fire-and-forget: a subscriber whose buffer is full misses it.
+func (m *memoryKV) Publish(channel string, msg Value) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var n int64
+	for sub := range m.subs[channel] {
+		select {
+		case sub.messages <- msg:
+		default:
+			log.Printf("dropping message on channel %s for slow subscriber", channel)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// Subscribe listens for messages published to channel after this call.
+func (m *memoryKV) Subscribe(channel string) (Subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sub := &memorySubscription{
+		kv:       m,
+		channel:  channel,
+		messages: make(chan Value, subscriptionBuffer),
+	}
+	if m.subs[channel] == nil {
+		m.subs[channel] = make(map[*memorySubscription]struct{})
+	}
+	m.subs[channel][sub] = struct{}{}
+	return sub, nil
+}
+
+// Ping always succeeds for the in-memory store.
+func (m *memoryKV) Ping() error {
+	return nil
+}
+
+// Do runs a Redis-style command against the in-memory store.
+func (m *memoryKV) Do(cmd string, args ...interface{}) (interface{}, error) {
+	return doCommand(m, cmd, args...)
+}
+
+// ttl reports whether key exists and its remaining time to live, which is
+// negative if the key has no expiry.
+func (m *memoryKV) ttl(key Key) (time.Duration, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item := m.lookup(string(key))
+	if item == nil {
+		return 0, false, nil
+	}
+	if item.expires.IsZero() {
+		return -1, true, nil
+	}
+	return item.expires.Sub(m.now()), true, nil
+}
+
+// memorySubscription is a Subscription on a memoryKV channel.
+type memorySubscription struct {
+	kv       *memoryKV
+	channel  string
+	messages chan Value
+	closed   bool
+}
+
+func (s *memorySubscription) Messages() <-chan Value {
+	return s.messages
+}
+
+// Close unsubscribes and closes the Messages channel. It is safe to call
+// more than once.
+func (s *memorySubscription) Close() error {
+	s.kv.mu.Lock()
+	defer s.kv.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	delete(s.kv.subs[s.channel], s)
+	if len(s.kv.subs[s.channel]) == 0 {
+		delete(s.kv.subs, s.channel)
+	}
+	close(s.messages)
+	return nil
+}
@@ -0,0 +1,148 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	testKV(t, m, &m.now)
+}
+
+// testKV runs the KV test suite against kv. now must point at the clock kv
+// uses for expiry.
+func testKV(t *testing.T, m KV, clock *func() time.Time) {
+	assert.NoError(t, m.Ping())
+
+	t.Run("test get and put", func(t *testing.T) {
+		val, ok, err := m.Get("missing")
+		assert.False(t, ok)
+		assert.True(t, ErrNotFound.Has(err))
+		assert.Equal(t, Value(""), val)
+
+		val, err = m.Put(Key("abc"), Value("value"))
+		assert.NoError(t, err)
+		assert.Equal(t, Value("value"), val)
+
+		val, ok, err = m.Get("abc")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, Value("value"), val)
+
+		n, err := m.Delete("abc", "missing")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+	})
+
+	t.Run("test expire", func(t *testing.T) {
+		now := time.Now()
+		*clock = func() time.Time { return now }
+		defer func() { *clock = time.Now }()
+
+		_, err := m.Put("ttl", "value")
+		assert.NoError(t, err)
+		ok, err := m.Expire("ttl", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ttl, err := m.Do("TTL", "ttl")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(60), ttl)
+
+		now = now.Add(time.Minute)
+		_, ok, err = m.Get("ttl")
+		assert.False(t, ok)
+		assert.True(t, ErrNotFound.Has(err))
+
+		ok, err = m.Expire("ttl", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("test lists", func(t *testing.T) {
+		n, err := m.LPush("list", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+		n, err = m.LPush("list", "c")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+
+		vals, err := m.LRange("list", 0, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, []Value{"c", "b", "a"}, vals)
+
+		vals, err = m.LRange("list", 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []Value{"b"}, vals)
+
+		vals, err = m.LRange("list", 5, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, vals)
+
+		_, _, err = m.Get("list")
+		assert.True(t, ErrWrongType.Has(err))
+	})
+
+	t.Run("test sets", func(t *testing.T) {
+		n, err := m.SAdd("set", "b", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+
+		n, err = m.SAdd("set", "a", "c")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		vals, err := m.SMembers("set")
+		assert.NoError(t, err)
+		assert.Equal(t, []Value{"a", "b", "c"}, vals)
+
+		_, err = m.LPush("set", "a")
+		assert.True(t, ErrWrongType.Has(err))
+	})
+
+	t.Run("test pub sub", func(t *testing.T) {
+		n, err := m.Publish("chan", "nobody")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+
+		sub, err := m.Subscribe("chan")
+		assert.NoError(t, err)
+
+		n, err = m.Publish("chan", "hello")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		assert.Equal(t, Value("hello"), <-sub.Messages())
+
+		assert.NoError(t, sub.Close())
+		assert.NoError(t, sub.Close())
+		_, open := <-sub.Messages()
+		assert.False(t, open)
+
+		n, err = m.Publish("chan", "gone")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+	})
+
+	t.Run("test do", func(t *testing.T) {
+		res, err := m.Do("SET", "do", "value")
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", res)
+
+		res, err = m.Do("GET", "do")
+		assert.NoError(t, err)
+		assert.Equal(t, "value", res)
+
+		res, err = m.Do("EXISTS", "do", "missing")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), res)
+
+		_, err = m.Do("GET", "missing")
+		assert.True(t, ErrNotFound.Has(err))
+
+		_, err = m.Do("HSET", "do", "field", "value")
+		assert.Error(t, err)
+	})
+}
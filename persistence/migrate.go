@@ -0,0 +1,129 @@
+package persistence
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/zeebo/errs"
+)
+
+// Migration is a single versioned change to a database schema. Down must
+// undo everything Up does.
+//
+// Exists is optional, for databases that were created before they had a
+// schema version. It's a query returning a count, and if the count isn't
+// zero the change is already there, so the migration is recorded as applied
+// without running Up.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+	Exists  string
+}
+
+const schemaVersionTable = `CREATE TABLE IF NOT EXISTS schema_version (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+// SchemaVersion returns the highest migration version applied to db, or 0
+// if none have been.
+func SchemaVersion(db *DB) (int, error) {
+	if _, err := db.Exec(schemaVersionTable); err != nil {
+		return 0, errs.New("failed to create schema_version table: %s", err)
+	}
+
+	var version int
+	err := db.db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_version`).Scan(&version)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return version, nil
+}
+
+// Migrate applies every migration newer than the current schema version.
+func Migrate(db *DB, migrations []Migration) error {
+	if len(migrations) == 0 {
+		return nil
+	}
+	return MigrateTo(db, migrations, migrations[len(migrations)-1].Version)
+}
+
+// MigrateTo moves db up or down to the given schema version. Each migration
+// runs in its own transaction, so a failure leaves the schema at the last
+// version that succeeded.
+func MigrateTo(db *DB, migrations []Migration, target int) error {
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return errs.New("migration %q has version %d; expected %d", m.Name, m.Version, i+1)
+		}
+	}
+	if target < 0 || target > len(migrations) {
+		return errs.New("no migration with version %d", target)
+	}
+
+	current, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	if current > len(migrations) {
+		return errs.New("database is at version %d but only %d migrations are known", current, len(migrations))
+	}
+
+	for current < target {
+		m := migrations[current]
+		log.Printf("applying migration %d: %s", m.Version, m.Name)
+		err := inTx(db, func(tx *sql.Tx) error {
+			exists := 0
+			if m.Exists != "" {
+				if err := tx.QueryRow(m.Exists).Scan(&exists); err != nil {
+					return err
+				}
+			}
+			if exists == 0 {
+				if _, err := tx.Exec(m.Up); err != nil {
+					return err
+				}
+			}
+			_, err := tx.Exec(`INSERT INTO schema_version (version, name) VALUES (?, ?)`, m.Version, m.Name)
+			return err
+		})
+		if err != nil {
+			return errs.New("failed to apply migration %d (%s): %s", m.Version, m.Name, err)
+		}
+		current++
+	}
+
+	for current > target {
+		m := migrations[current-1]
+		log.Printf("reverting migration %d: %s", m.Version, m.Name)
+		err := inTx(db, func(tx *sql.Tx) error {
+			if _, err := tx.Exec(m.Down); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`DELETE FROM schema_version WHERE version = ?`, m.Version)
+			return err
+		})
+		if err != nil {
+			return errs.New("failed to revert migration %d (%s): %s", m.Version, m.Name, err)
+		}
+		current--
+	}
+
+	return nil
+}
+
+// inTx runs fn in a transaction on db, committing if it returns nil.
+func inTx(db *DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return errs.Wrap(tx.Commit())
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edhgo-migrate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := NewSQLite(filepath.Join(dir, "db.sqlite"))
+	assert.NoError(t, err)
+
+	tableExists := func(name string) bool {
+		var n int
+		err := db.db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, name).Scan(&n)
+		assert.NoError(t, err)
+		return n == 1
+	}
+
+	t.Run("test migrate up", func(t *testing.T) {
+		version, err := SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, version)
+
+		assert.NoError(t, Migrate(db, Migrations))
+		version, err = SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, len(Migrations), version)
+		assert.True(t, tableExists("users"))
+		assert.True(t, tableExists("decks"))
+		assert.True(t, tableExists("games"))
+		assert.True(t, tableExists("kv"))
+
+		// running again is a no-op
+		assert.NoError(t, Migrate(db, Migrations))
+	})
+
+	t.Run("test migrate down", func(t *testing.T) {
+		assert.NoError(t, MigrateTo(db, Migrations, 1))
+		version, err := SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, version)
+		assert.True(t, tableExists("users"))
+		assert.False(t, tableExists("decks"))
+
+		assert.NoError(t, MigrateTo(db, Migrations, 0))
+		assert.False(t, tableExists("users"))
+	})
+
+	t.Run("test failed migration is rolled back", func(t *testing.T) {
+		bad := append([]Migration{}, Migrations...)
+		bad = append(bad, Migration{
+			Version: len(Migrations) + 1,
+			Name:    "broken",
+			Up:      `CREATE TABLE broken (id TEXT); SELECT * FROM nope;`,
+			Down:    `DROP TABLE broken;`,
+		})
+		assert.Error(t, Migrate(db, bad))
+
+		version, err := SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, len(Migrations), version)
+		assert.False(t, tableExists("broken"))
+	})
+
+	t.Run("test skips changes that already exist", func(t *testing.T) {
+		_, err := db.Exec(`CREATE TABLE legacy (id TEXT)`)
+		assert.NoError(t, err)
+		existing := append([]Migration{}, Migrations...)
+		existing = append(existing, Migration{
+			Version: len(Migrations) + 1,
+			Name:    "create legacy",
+			Up:      `CREATE TABLE legacy (id TEXT);`,
+			Down:    `DROP TABLE legacy;`,
+			Exists:  `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'legacy'`,
+		})
+		assert.NoError(t, Migrate(db, existing))
+
+		version, err := SchemaVersion(db)
+		assert.NoError(t, err)
+		assert.Equal(t, len(existing), version)
+		assert.NoError(t, MigrateTo(db, existing, len(Migrations)))
+		assert.False(t, tableExists("legacy"))
+	})
+
+	t.Run("test out of order versions", func(t *testing.T) {
+		err := Migrate(db, []Migration{{Version: 2, Name: "skipped"}})
+		assert.Error(t, err)
+	})
+}
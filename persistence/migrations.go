@@ -0,0 +1,86 @@
+package persistence
+
+// Migrations is the ordered list of schema migrations for the app database.
+// Versions must start at 1 and increase by one. Never edit a migration once
+// it has shipped; add a new one instead.
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "create users",
+		Up: `CREATE TABLE users (
+			id TEXT PRIMARY KEY,
+			username TEXT NOT NULL UNIQUE,
+			email TEXT UNIQUE,
+			password_hash TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);`,
+		Down: `DROP TABLE users;`,
+	},
+	{
+		Version: 2,
+		Name:    "create decks",
+		Up: `CREATE TABLE decks (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			name TEXT NOT NULL,
+			format TEXT NOT NULL DEFAULT 'EDH',
+			commander TEXT,
+			decklist TEXT NOT NULL,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE INDEX decks_user_id ON decks (user_id);`,
+		Down: `DROP INDEX decks_user_id;
+		DROP TABLE decks;`,
+	},
+	{
+		Version: 3,
+		Name:    "create game history",
+		Up: `CREATE TABLE games (
+			id TEXT PRIMARY KEY,
+			handle TEXT,
+			format TEXT NOT NULL DEFAULT 'EDH',
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			finished_at DATETIME,
+			winner_id TEXT REFERENCES users (id)
+		);
+		CREATE TABLE game_players (
+			game_id TEXT NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+			user_id TEXT NOT NULL REFERENCES users (id),
+			deck_id TEXT REFERENCES decks (id),
+			seat INTEGER,
+			PRIMARY KEY (game_id, user_id)
+		);
+		CREATE INDEX game_players_user_id ON game_players (user_id);`,
+		Down: `DROP INDEX game_players_user_id;
+		DROP TABLE game_players;
+		DROP TABLE games;`,
+	},
+	{
+		// The KV store holds every key's type and expiry in kv, with list
+		// elements and set members in their own tables keyed off of it.
+		// Databases from before this migration may already have them.
+		Version: 4,
+		Name:    "create kv store",
+		Up: `CREATE TABLE IF NOT EXISTS kv (
+			key TEXT PRIMARY KEY,
+			kind INTEGER NOT NULL,
+			value TEXT,
+			expires_at INTEGER
+		);
+		CREATE TABLE IF NOT EXISTS kv_list (
+			key TEXT NOT NULL,
+			pos INTEGER NOT NULL,
+			value TEXT NOT NULL,
+			PRIMARY KEY (key, pos)
+		);
+		CREATE TABLE IF NOT EXISTS kv_set (
+			key TEXT NOT NULL,
+			member TEXT NOT NULL,
+			PRIMARY KEY (key, member)
+		);`,
+		Down: `DROP TABLE kv_set;
+		DROP TABLE kv_list;
+		DROP TABLE kv;`,
+	},
+}
@@ -2,8 +2,18 @@ package persistence
 
 import (
 	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
 )
 
+// ErrNotFound is returned by a KV when a key does not exist or has expired.
+var ErrNotFound = errs.Class("key not found")
+
+// ErrWrongType is returned by a KV when an operation is run against a key
+// holding a different kind of value, e.g. LPush on a plain string key.
+var ErrWrongType = errs.Class("wrong type")
+
 // Value is a type for handling and validating Values in the game engine
 type Value string
 
@@ -17,11 +27,49 @@ type Persistence interface {
 	Get(key Key) (Value, bool, error)
 }
 
-// KV is the KV store for the game engine to work with.
+// KV is the KV store for the game engine to work with. Its semantics follow
+// Redis so that any implementation can be swapped in for the Redis backend.
 type KV interface {
 	Put(key Key, val Value) (Value, error)
 	Get(key Key) (Value, bool, error)
 	Do(cmd string, args ...interface{}) (interface{}, error)
+
+	// Delete removes the given keys and returns how many existed.
+	Delete(keys ...Key) (int64, error)
+	// Expire sets a time to live on a key. It returns false if the key
+	// does not exist.
+	Expire(key Key, ttl time.Duration) (bool, error)
+
+	// LPush prepends values to the list at key and returns its new length.
+	LPush(key Key, vals ...Value) (int64, error)
+	// LRange returns the elements of the list at key between start and stop,
+	// inclusive. Negative indexes count from the end of the list.
+	LRange(key Key, start, stop int64) ([]Value, error)
+
+	// SAdd adds members to the set at key and returns how many were new.
+	SAdd(key Key, members ...Value) (int64, error)
+	// SMembers returns every member of the set at key.
+	SMembers(key Key) ([]Value, error)
+
+	// Publish sends msg to every subscriber of channel and returns how many
+	// subscribers received it.
+	Publish(channel string, msg Value) (int64, error)
+	// Subscribe listens for messages published to channel.
+	Subscribe(channel string) (Subscription, error)
+
+	// Ping returns an error if the store can't be reached.
+	Ping() error
+}
+
+// subscriptionBuffer is how many published messages a Subscription holds
+// before new messages are dropped for a slow reader.
+const subscriptionBuffer = 100
+
+// Subscription is a handle to a pub/sub channel on a KV. Close must be called
+// to release it.
+type Subscription interface {
+	Messages() <-chan Value
+	Close() error
 }
 
 // Database must be fulfilled for the cards package to operate correctly.
@@ -1,6 +1,9 @@
 package persistence
 
 import (
+	"sync"
+	"time"
+
 	"github.com/zeebo/errs"
 
 	"github.com/go-redis/redis/v7"
@@ -11,13 +14,23 @@ type redisDB struct {
 	client *redis.Client
 }
 
+// Force redisDB to fulfill KV
+var _ = (KV)(&redisDB{})
+
 type Config map[string]string
 
 // NewRedis returns a new Redis Persistence that can be used
 // in the application to persist and update state.
+// The `addr` config value is used as the Redis address and defaults to
+// localhost:6379.
 func NewRedis(config Config) (*redisDB, error) {
+	addr := "localhost:6379"
+	if a, ok := config["addr"]; ok && a != "" {
+		addr = a
+	}
+
 	client := redis.NewClient(&redis.Options{
-		Addr:     "localhost:6379",
+		Addr:     addr,
 		Password: "", // no password set
 		DB:       0,  // use default DB
 	})
@@ -51,6 +64,9 @@ func (r *redisDB) Get(key Key) (Value, bool, error) {
 	}
 
 	val, err := r.client.Get(k).Result()
+	if err == redis.Nil {
+		return Value(""), false, ErrNotFound.New("%s", k)
+	}
 	if err != nil {
 		return Value(""), false, errs.New("error getting key from redis client: %s", err)
 	}
@@ -61,7 +77,137 @@ func (r *redisDB) Get(key Key) (Value, bool, error) {
 // Do runs a redigo-style Do command through the Key Value store. This is
 // generally for use with Redis commands.
 func (r *redisDB) Do(cmd string, args ...interface{}) (interface{}, error) {
-	return nil, errs.New("not impl")
+	res, err := r.client.Do(append([]interface{}{cmd}, args...)...).Result()
+	if err == redis.Nil {
+		return nil, ErrNotFound.New("%s", cmd)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return res, nil
+}
+
+// Delete removes keys from Redis and returns how many were removed.
+func (r *redisDB) Delete(keys ...Key) (int64, error) {
+	n, err := r.client.Del(keyStrings(keys)...).Result()
+	return n, errs.Wrap(err)
+}
+
+// Expire sets a time to live on key.
+func (r *redisDB) Expire(key Key, ttl time.Duration) (bool, error) {
+	ok, err := r.client.Expire(string(key), ttl).Result()
+	return ok, errs.Wrap(err)
+}
+
+// LPush prepends vals to the list at key.
+func (r *redisDB) LPush(key Key, vals ...Value) (int64, error) {
+	n, err := r.client.LPush(string(key), valueArgs(vals)...).Result()
+	return n, errs.Wrap(err)
+}
+
+// LRange returns a range of the list at key.
+func (r *redisDB) LRange(key Key, start, stop int64) ([]Value, error) {
+	res, err := r.client.LRange(string(key), start, stop).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return toValues(res), nil
+}
+
+// SAdd adds members to the set at key.
+func (r *redisDB) SAdd(key Key, members ...Value) (int64, error) {
+	n, err := r.client.SAdd(string(key), valueArgs(members)...).Result()
+	return n, errs.Wrap(err)
+}
+
+// SMembers returns the members of the set at key.
+func (r *redisDB) SMembers(key Key) ([]Value, error) {
+	res, err := r.client.SMembers(string(key)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return toValues(res), nil
+}
+
+// Publish sends msg to the Redis channel.
+func (r *redisDB) Publish(channel string, msg Value) (int64, error) {
+	n, err := r.client.Publish(channel, string(msg)).Result()
+	return n, errs.Wrap(err)
+}
+
+// Subscribe subscribes to a Redis channel. The subscription is confirmed
+// before it is returned so that no messages published afterwards are missed.
+func (r *redisDB) Subscribe(channel string) (Subscription, error) {
+	ps := r.client.Subscribe(channel)
+	if _, err := ps.Receive(); err != nil {
+		_ = ps.Close()
+		return nil, errs.Wrap(err)
+	}
+
+	sub := &redisSubscription{
+		ps:       ps,
+		messages: make(chan Value, subscriptionBuffer),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(sub.messages)
+		for m := range ps.Channel() {
+			select {
+			case sub.messages <- Value(m.Payload):
+			case <-sub.done:
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// Ping checks the connection to Redis.
+func (r *redisDB) Ping() error {
+	return errs.Wrap(r.client.Ping().Err())
+}
+
+// redisSubscription adapts a Redis PubSub to a Subscription.
+type redisSubscription struct {
+	ps       *redis.PubSub
+	messages chan Value
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (s *redisSubscription) Messages() <-chan Value {
+	return s.messages
+}
+
+func (s *redisSubscription) Close() error {
+	s.once.Do(func() { close(s.done) })
+	return errs.Wrap(s.ps.Close())
+}
+
+func keyStrings(keys []Key) []string {
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, string(k))
+	}
+	return out
+}
+
+func valueArgs(vals []Value) []interface{} {
+	out := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		out = append(out, string(v))
+	}
+	return out
+}
+
+func toValues(res []string) []Value {
+	out := make([]Value, 0, len(res))
+	for _, v := range res {
+		out = append(out, Value(v))
+	}
+	return out
 }
 
 // String returns the string of Value.
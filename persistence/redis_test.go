@@ -19,6 +19,10 @@ func TestNewRedis(t *testing.T) {
 		t.Fail()
 	}
 
+	if err := r.Ping(); err != nil {
+		t.Skipf("unable to connect to redis - skipping redis tests")
+	}
+
 	val, ok, err := r.Get("testkey")
 	if ok {
 		t.Fail()
@@ -57,3 +57,8 @@ func (db *DB) Stats() sql.DBStats {
 func (db *DB) Prepare(query string) (*sql.Stmt, error) {
 	return db.db.Prepare(query)
 }
+
+// Begin starts a transaction. The caller must Commit or Rollback it.
+func (db *DB) Begin() (*sql.Tx, error) {
+	return db.db.Begin()
+}
@@ -0,0 +1,343 @@
+package persistence
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// sqliteKV implements KV on top of a SQLite database so that game, board,
+// and chat state survive a restart without running Redis. Lists and sets are
+// emulated with their own tables. Pub/sub is not durable in Redis either, so
+// it is handled in-process.
+type sqliteKV struct {
+	// mu serializes writes so that concurrent requests don't hit
+	// SQLITE_BUSY on a single file.
+	mu sync.Mutex
+	db *DB
+
+	pubsub *memoryKV
+
+	// now is swappable so that expiry can be tested without sleeping.
+	now func() time.Time
+}
+
+// Force sqliteKV to fulfill KV
+var _ = (KV)(&sqliteKV{})
+
+// NewSQLiteKV returns a KV that stores its keys in db, migrating db first
+// so that its tables exist.
+func NewSQLiteKV(db *DB) (*sqliteKV, error) {
+	if err := Migrate(db, Migrations); err != nil {
+		return nil, err
+	}
+
+	return &sqliteKV{
+		db:     db,
+		pubsub: NewMemory(),
+		now:    time.Now,
+	}, nil
+}
+
+// tx runs fn in a transaction, committing if it returns nil.
+func (s *sqliteKV) tx(fn func(tx *sql.Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return inTx(s.db, fn)
+}
+
+// lookup evicts key if it has expired and then returns its kind and expiry,
+// if it exists.
+func (s *sqliteKV) lookup(tx *sql.Tx, key Key) (kind itemKind, expires *int64, ok bool, err error) {
+	var k int
+	err = tx.QueryRow(`SELECT kind, expires_at FROM kv WHERE key = ?`, string(key)).Scan(&k, &expires)
+	if err == sql.ErrNoRows {
+		return 0, nil, false, nil
+	}
+	if err != nil {
+		return 0, nil, false, errs.Wrap(err)
+	}
+	if expires != nil && *expires <= s.now().UnixNano() {
+		return 0, nil, false, s.remove(tx, key)
+	}
+	return itemKind(k), expires, true, nil
+}
+
+// lookupKind returns whether key exists, or ErrWrongType if it holds a
+// different kind of value.
+func (s *sqliteKV) lookupKind(tx *sql.Tx, key Key, kind itemKind) (bool, error) {
+	k, _, ok, err := s.lookup(tx, key)
+	if err != nil || !ok {
+		return false, err
+	}
+	if k != kind {
+		return false, ErrWrongType.New("%s", key)
+	}
+	return true, nil
+}
+
+// remove deletes every row belonging to key.
+func (s *sqliteKV) remove(tx *sql.Tx, key Key) error {
+	for _, q := range []string{
+		`DELETE FROM kv WHERE key = ?`,
+		`DELETE FROM kv_list WHERE key = ?`,
+		`DELETE FROM kv_set WHERE key = ?`,
+	} {
+		if _, err := tx.Exec(q, string(key)); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Put sets key to val, overwriting a key of any type and clearing its expiry.
+func (s *sqliteKV) Put(key Key, val Value) (Value, error) {
+	err := s.tx(func(tx *sql.Tx) error {
+		if err := s.remove(tx, key); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`INSERT INTO kv (key, kind, value) VALUES (?, ?, ?)`,
+			string(key), kindString, string(val))
+		return errs.Wrap(err)
+	})
+	if err != nil {
+		return Value(""), err
+	}
+	return val, nil
+}
+
+// Get returns the value at key. A missing key returns ErrNotFound.
+func (s *sqliteKV) Get(key Key) (Value, bool, error) {
+	var val Value
+	err := s.tx(func(tx *sql.Tx) error {
+		ok, err := s.lookupKind(tx, key, kindString)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrNotFound.New("%s", key)
+		}
+		return errs.Wrap(tx.QueryRow(`SELECT value FROM kv WHERE key = ?`, string(key)).Scan(&val))
+	})
+	if err != nil {
+		return Value(""), false, err
+	}
+	return val, true, nil
+}
+
+// Delete removes keys and returns how many existed.
+func (s *sqliteKV) Delete(keys ...Key) (int64, error) {
+	var n int64
+	err := s.tx(func(tx *sql.Tx) error {
+		for _, k := range keys {
+			_, _, ok, err := s.lookup(tx, k)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if err := s.remove(tx, k); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// Expire sets a time to live on key. A ttl of zero or less deletes the key.
+func (s *sqliteKV) Expire(key Key, ttl time.Duration) (bool, error) {
+	var found bool
+	err := s.tx(func(tx *sql.Tx) error {
+		_, _, ok, err := s.lookup(tx, key)
+		if err != nil || !ok {
+			return err
+		}
+		found = true
+		if ttl <= 0 {
+			return s.remove(tx, key)
+		}
+		_, err = tx.Exec(`UPDATE kv SET expires_at = ? WHERE key = ?`,
+			s.now().Add(ttl).UnixNano(), string(key))
+		return errs.Wrap(err)
+	})
+	return found, err
+}
+
+// LPush prepends vals to the list at key. Positions only ever decrease at
+// the head so that existing elements never need renumbering.
+func (s *sqliteKV) LPush(key Key, vals ...Value) (int64, error) {
+	var n int64
+	err := s.tx(func(tx *sql.Tx) error {
+		ok, err := s.lookupKind(tx, key, kindList)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if _, err := tx.Exec(`INSERT INTO kv (key, kind) VALUES (?, ?)`, string(key), kindList); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+
+		var head int64
+		err = tx.QueryRow(`SELECT COALESCE(MIN(pos), 0) FROM kv_list WHERE key = ?`, string(key)).Scan(&head)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		for _, v := range vals {
+			head--
+			_, err := tx.Exec(`INSERT INTO kv_list (key, pos, value) VALUES (?, ?, ?)`, string(key), head, string(v))
+			if err != nil {
+				return errs.Wrap(err)
+			}
+		}
+
+		return errs.Wrap(tx.QueryRow(`SELECT COUNT(*) FROM kv_list WHERE key = ?`, string(key)).Scan(&n))
+	})
+	return n, err
+}
+
+// LRange returns the elements of the list at key from start to stop inclusive.
+func (s *sqliteKV) LRange(key Key, start, stop int64) ([]Value, error) {
+	out := []Value{}
+	err := s.tx(func(tx *sql.Tx) error {
+		ok, err := s.lookupKind(tx, key, kindList)
+		if err != nil || !ok {
+			return err
+		}
+
+		var n int64
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM kv_list WHERE key = ?`, string(key)).Scan(&n); err != nil {
+			return errs.Wrap(err)
+		}
+		offset, count := listRange(start, stop, n)
+		if count == 0 {
+			return nil
+		}
+
+		rows, err := tx.Query(`SELECT value FROM kv_list WHERE key = ? ORDER BY pos LIMIT ? OFFSET ?`,
+			string(key), count, offset)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var v string
+			if err := rows.Scan(&v); err != nil {
+				return errs.Wrap(err)
+			}
+			out = append(out, Value(v))
+		}
+		return errs.Wrap(rows.Err())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SAdd adds members to the set at key and returns how many were new.
+func (s *sqliteKV) SAdd(key Key, members ...Value) (int64, error) {
+	var added int64
+	err := s.tx(func(tx *sql.Tx) error {
+		ok, err := s.lookupKind(tx, key, kindSet)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if _, err := tx.Exec(`INSERT INTO kv (key, kind) VALUES (?, ?)`, string(key), kindSet); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+
+		for _, m := range members {
+			res, err := tx.Exec(`INSERT OR IGNORE INTO kv_set (key, member) VALUES (?, ?)`, string(key), string(m))
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			added += n
+		}
+		return nil
+	})
+	return added, err
+}
+
+// SMembers returns the members of the set at key, sorted.
+func (s *sqliteKV) SMembers(key Key) ([]Value, error) {
+	out := []Value{}
+	err := s.tx(func(tx *sql.Tx) error {
+		ok, err := s.lookupKind(tx, key, kindSet)
+		if err != nil || !ok {
+			return err
+		}
+
+		rows, err := tx.Query(`SELECT member FROM kv_set WHERE key = ? ORDER BY member`, string(key))
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var v string
+			if err := rows.Scan(&v); err != nil {
+				return errs.Wrap(err)
+			}
+			out = append(out, Value(v))
+		}
+		return errs.Wrap(rows.Err())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Publish delivers msg to subscribers in this process.
+func (s *sqliteKV) Publish(channel string, msg Value) (int64, error) {
+	return s.pubsub.Publish(channel, msg)
+}
+
+// Subscribe listens for messages published to channel in this process.
+func (s *sqliteKV) Subscribe(channel string) (Subscription, error) {
+	return s.pubsub.Subscribe(channel)
+}
+
+// Ping checks the connection to the database.
+func (s *sqliteKV) Ping() error {
+	return errs.Wrap(s.db.Ping())
+}
+
+// Do runs a Redis-style command against the SQLite store.
+func (s *sqliteKV) Do(cmd string, args ...interface{}) (interface{}, error) {
+	return doCommand(s, cmd, args...)
+}
+
+// ttl reports whether key exists and its remaining time to live, which is
+// negative if the key has no expiry.
+func (s *sqliteKV) ttl(key Key) (time.Duration, bool, error) {
+	var (
+		ttl   time.Duration
+		found bool
+	)
+	err := s.tx(func(tx *sql.Tx) error {
+		_, expires, ok, err := s.lookup(tx, key)
+		if err != nil || !ok {
+			return err
+		}
+		found = true
+		ttl = -1
+		if expires != nil {
+			ttl = time.Duration(*expires - s.now().UnixNano())
+		}
+		return nil
+	})
+	return ttl, found, err
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteKV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edhgo-kv")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "kv.sqlite")
+
+	db, err := NewSQLite(path)
+	assert.NoError(t, err)
+	kv, err := NewSQLiteKV(db)
+	assert.NoError(t, err)
+
+	testKV(t, kv, &kv.now)
+
+	t.Run("test state survives reopening", func(t *testing.T) {
+		_, err := kv.Put("game", "state")
+		assert.NoError(t, err)
+		_, err = kv.LPush("messages", "hello")
+		assert.NoError(t, err)
+		assert.NoError(t, db.db.Close())
+
+		db, err := NewSQLite(path)
+		assert.NoError(t, err)
+		reopened, err := NewSQLiteKV(db)
+		assert.NoError(t, err)
+
+		val, ok, err := reopened.Get("game")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, Value("state"), val)
+
+		vals, err := reopened.LRange("messages", 0, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, []Value{"hello"}, vals)
+	})
+}
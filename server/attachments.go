@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+)
+
+// Attach attaches one of the player's permanents, like an Aura or an
+// Equipment, to a permanent on any player's battlefield in the game. cardID
+// and targetID are instance IDs.
+func (s *graphQLServer) Attach(ctx context.Context, gameID string, username string, cardID string, targetID string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		card := findPermanent(updated, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+		if cardID == targetID {
+			return ErrBoardState.New("%s can't be attached to itself", card.Name)
+		}
+
+		var target *Card
+		for _, bs := range boards {
+			if target = findPermanent(bs, targetID); target != nil {
+				break
+			}
+		}
+		if target == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", targetID)
+		}
+		if target.AttachedTo != nil && *target.AttachedTo == cardID {
+			return ErrBoardState.New("%s is attached to %s", target.Name, card.Name)
+		}
+		card.AttachedTo = &targetID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), nil
+}
+
+// Detach unattaches one of the player's permanents from whatever it's
+// attached to. It stays on the battlefield, even if it's an Aura, so that
+// the player can move it where it needs to go.
+func (s *graphQLServer) Detach(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		card := findPermanent(bs, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+		card.AttachedTo = nil
+		return nil
+	})
+}
+
+// settleAttachments applies the state-based actions for attached cards
+// across every board in a game. Cards that aren't on the battlefield aren't
+// attached to anything. A card attached to something that left the
+// battlefield becomes unattached, except for Auras, which are put into the
+// graveyard.
+func settleAttachments(boards map[string]*BoardState) {
+	for {
+		onField := map[string]bool{}
+		for _, bs := range boards {
+			for _, c := range battlefield(bs) {
+				onField[instanceID(c)] = true
+			}
+		}
+
+		moved := false
+		for _, bs := range boards {
+			for _, zone := range zones(bs) {
+				for _, c := range zone {
+					if c.AttachedTo != nil && !onField[instanceID(c)] {
+						c.AttachedTo = nil
+					}
+				}
+			}
+
+			var fell []*Card
+			bs.Field, fell = unattached(bs.Field, onField)
+			bs.Graveyard = append(bs.Graveyard, fell...)
+			bs.Controlled, fell = unattached(bs.Controlled, onField)
+			bs.Graveyard = append(bs.Graveyard, fell...)
+			moved = moved || len(fell) > 0
+		}
+
+		// an Aura that left may have had something attached to it
+		if !moved {
+			return
+		}
+	}
+}
+
+// unattached unattaches the permanents in a zone that are attached to
+// something that isn't on the battlefield. It returns the zone without the
+// Auras among them, and the Auras, which are put into the graveyard.
+func unattached(zone []*Card, onField map[string]bool) (stay, fell []*Card) {
+	stay = zone[:0]
+	for _, c := range zone {
+		if c.AttachedTo == nil || onField[*c.AttachedTo] {
+			stay = append(stay, c)
+			continue
+		}
+		c.AttachedTo = nil
+		if !hasSubtype(c, "Aura") {
+			stay = append(stay, c)
+			continue
+		}
+		leaveBattlefield(c)
+		fell = append(fell, c)
+	}
+	return stay, fell
+}
+
+// leaveBattlefield resets the state a card only has on the battlefield when
+// it moves to another zone.
+func leaveBattlefield(c *Card) {
+	c.Tapped = nil
+	c.Counters = nil
+	c.AttachedTo = nil
+}
+
+// instanceID returns a card's instance ID, or "" if it has none.
+func instanceID(c *Card) string {
+	if c.InstanceID == nil {
+		return ""
+	}
+	return *c.InstanceID
+}
+
+// hasSubtype returns true if the card's subtypes include subtype. A
+// face-down card has none.
+func hasSubtype(c *Card, subtype string) bool {
+	if isFaceDown(c) {
+		return false
+	}
+	return listContains(c.Subtypes, subtype)
+}
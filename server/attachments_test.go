@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachments(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	teysa := testCard(t, s, "Teysa Karlov")
+	pacifism := &Card{Name: "Pacifism", Types: str("Enchantment"), Subtypes: str("Aura"), InstanceID: newInstanceID()}
+	bonesplitter := &Card{Name: "Bonesplitter", Types: str("Artifact"), Subtypes: str("Equipment"), InstanceID: newInstanceID()}
+	putBoard(t, s, "alice", &BoardState{
+		Field: []*Card{angel, pacifism, bonesplitter},
+	})
+	putBoard(t, s, "bob", &BoardState{
+		Field: []*Card{teysa},
+	})
+
+	t.Run("test attaches to any battlefield", func(t *testing.T) {
+		bs, err := s.Attach(ctx, "game", "alice", *pacifism.InstanceID, *teysa.InstanceID)
+		assert.NoError(t, err)
+		assert.Equal(t, *teysa.InstanceID, *findCard(bs.Field, *pacifism.InstanceID).AttachedTo)
+
+		bs, err = s.Attach(ctx, "game", "alice", *bonesplitter.InstanceID, *angel.InstanceID)
+		assert.NoError(t, err)
+		assert.Equal(t, *angel.InstanceID, *findCard(bs.Field, *bonesplitter.InstanceID).AttachedTo)
+	})
+
+	t.Run("test rejects bad attachments", func(t *testing.T) {
+		_, err := s.Attach(ctx, "game", "alice", *angel.InstanceID, *angel.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.Attach(ctx, "game", "alice", *angel.InstanceID, "missing")
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.Attach(ctx, "game", "alice", *teysa.InstanceID, *angel.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test auras go to the graveyard when their host leaves", func(t *testing.T) {
+		bob, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		_, err = s.UpdateBoardState(ctx, InputBoardState{
+			User:      &InputUser{Username: "bob"},
+			GameID:    "game",
+			Graveyard: []*InputCard{{Name: bob.Field[0].Name, InstanceID: bob.Field[0].InstanceID}},
+		})
+		assert.NoError(t, err)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Nil(t, findCard(alice.Field, *pacifism.InstanceID))
+		moved := findCard(alice.Graveyard, *pacifism.InstanceID)
+		if assert.NotNil(t, moved) {
+			assert.Nil(t, moved.AttachedTo)
+		}
+	})
+
+	t.Run("test equipment stays when its host leaves", func(t *testing.T) {
+		_, err := s.updateBoardState("game", "alice", func(bs *BoardState) error {
+			bs.Exiled = append(bs.Exiled, findCard(bs.Field, *angel.InstanceID))
+			bs.Field = []*Card{findCard(bs.Field, *bonesplitter.InstanceID)}
+			return nil
+		})
+		assert.NoError(t, err)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		equipment := findCard(alice.Field, *bonesplitter.InstanceID)
+		if assert.NotNil(t, equipment) {
+			assert.Nil(t, equipment.AttachedTo)
+		}
+	})
+
+	t.Run("test detaches", func(t *testing.T) {
+		bs, err := s.Attach(ctx, "game", "alice", *bonesplitter.InstanceID, *pacifism.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+		assert.Nil(t, bs)
+
+		putBoard(t, s, "bob", &BoardState{Field: []*Card{teysa}})
+		_, err = s.Attach(ctx, "game", "alice", *bonesplitter.InstanceID, *teysa.InstanceID)
+		assert.NoError(t, err)
+		bs, err = s.Detach(ctx, "game", "alice", *bonesplitter.InstanceID)
+		assert.NoError(t, err)
+		assert.Nil(t, findCard(bs.Field, *bonesplitter.InstanceID).AttachedTo)
+	})
+}
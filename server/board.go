@@ -0,0 +1,260 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// ErrBoardState is returned when a board state change isn't allowed.
+var ErrBoardState = errs.Class("board state")
+
+// boardState loads a player's board state.
+func (s *graphQLServer) boardState(gameID, username string) (*BoardState, error) {
+	bs := &BoardState{}
+	err := s.Get(BoardStateKey(gameID, username), bs)
+	if persistence.ErrNotFound.Has(err) {
+		return nil, ErrBoardState.New("no boardstate for user %s found", username)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return bs, nil
+}
+
+// updateBoardState loads a player's board state, applies fn to it, and
+// saves and publishes the result. Nothing is saved if fn returns an error.
+// Updates are serialized so that concurrent mutations don't overwrite each
+// other. The result is returned as the player sees it.
+func (s *graphQLServer) updateBoardState(gameID, username string, fn func(bs *BoardState) error) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		var ok bool
+		if updated, ok = boards[username]; !ok {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		return fn(updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), nil
+}
+
+// updateBoardStates loads the board states of every player in a game, keyed
+// by username, applies fn to them, and then applies the game's state-based
+// actions to the result, since a change on one board can affect cards on
+// another. Boards that changed are saved and published. Nothing is saved if
+// fn returns an error.
+//
+// username is the player making the change. Players who haven't set up a
+// board yet are left out, and fn can add one to boards.
+func (s *graphQLServer) updateBoardStates(gameID, username string, fn func(boards map[string]*BoardState) error) error {
+	s.boardMutex.Lock()
+	defer s.boardMutex.Unlock()
+
+	boards := map[string]*BoardState{}
+	before := map[string][]byte{}
+	for _, player := range s.gamePlayers(gameID, username) {
+		bs, err := s.boardState(gameID, player)
+		if ErrBoardState.Has(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if bs.User == nil {
+			bs.User = &User{Username: player}
+		}
+		boards[player] = bs
+		if before[player], err = json.Marshal(bs); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	if err := fn(boards); err != nil {
+		return err
+	}
+	applyStateBasedActions(boards)
+
+	for player, bs := range boards {
+		after, err := json.Marshal(bs)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if bytes.Equal(before[player], after) {
+			continue
+		}
+		if err := s.Set(BoardStateKey(gameID, player), bs); err != nil {
+			return errs.Wrap(err)
+		}
+		s.publishBoardState(player, bs)
+	}
+	return nil
+}
+
+// applyStateBasedActions applies the state-based actions the server keeps
+// track of to every board in a game.
+func applyStateBasedActions(boards map[string]*BoardState) {
+	settleAttachments(boards)
+	settleControl(boards)
+	removeTokens(boards)
+	revealFaceDown(boards)
+}
+
+// gamePlayers returns the usernames of a game's players, always including
+// username even if they aren't in the game directory yet.
+func (s *graphQLServer) gamePlayers(gameID, username string) []string {
+	players := []string{username}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	game, ok := s.Directory[gameID]
+	if !ok {
+		return players
+	}
+	for _, u := range game.PlayerIDs {
+		if u != nil && u.Username != username {
+			players = append(players, u.Username)
+		}
+	}
+	return players
+}
+
+// publishBoardState sends a board state to the player's boardUpdate
+// subscription, if they have one, as the player sees it. It doesn't wait on
+// slow subscribers.
+func (s *graphQLServer) publishBoardState(username string, bs *BoardState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ch, ok := s.boardChannels[username]
+	if !ok {
+		return
+	}
+	select {
+	case ch <- visibleTo(bs, username):
+	default:
+		log.Printf("dropped board state update for %s", username)
+	}
+}
+
+// findCard returns the card with the given instance ID in a zone, or nil.
+func findCard(zone []*Card, instanceID string) *Card {
+	for _, c := range zone {
+		if c.InstanceID != nil && *c.InstanceID == instanceID {
+			return c
+		}
+	}
+	return nil
+}
+
+// newInstanceID returns a new instance ID for a card in a game. Every copy
+// of a card gets its own instance ID, unlike its ID which is shared by every
+// copy of the same printing, and keeps it as it moves between zones.
+func newInstanceID() *string {
+	id := uuid.New().String()
+	return &id
+}
+
+// amountOrOne returns the amount a mutation was given, or 1 if it wasn't.
+func amountOrOne(amount *int) int {
+	if amount == nil {
+		return 1
+	}
+	return *amount
+}
+
+// battlefield returns the player's permanents: the cards on their Field,
+// and the cards in Controlled that they control but don't own.
+func battlefield(bs *BoardState) []*Card {
+	out := make([]*Card, 0, len(bs.Field)+len(bs.Controlled))
+	return append(append(out, bs.Field...), bs.Controlled...)
+}
+
+// findPermanent returns the permanent with the given instance ID on the
+// player's battlefield, or nil.
+func findPermanent(bs *BoardState, instanceID string) *Card {
+	return findCard(battlefield(bs), instanceID)
+}
+
+// zones returns every zone of a board state.
+func zones(bs *BoardState) [][]*Card {
+	return [][]*Card{
+		bs.Commander, bs.Library, bs.Graveyard, bs.Exiled,
+		bs.Field, bs.Hand, bs.Controlled, bs.Revealed,
+	}
+}
+
+// assignInstanceIDs gives a new instance ID to every card on the board
+// that doesn't have one yet, or that shares one with another card.
+func assignInstanceIDs(bs *BoardState) {
+	seen := map[string]bool{}
+	for _, zone := range zones(bs) {
+		for _, c := range zone {
+			if c == nil {
+				continue
+			}
+			if c.InstanceID == nil || *c.InstanceID == "" || seen[*c.InstanceID] {
+				c.InstanceID = newInstanceID()
+			}
+			seen[*c.InstanceID] = true
+		}
+	}
+}
+
+// Transform turns over a double-faced card, or flips a flip card, on the
+// player's battlefield. Card details are swapped to those of the face that's
+// showing, and Flipped is true while it isn't the front face.
+// cardID is the card's instance ID.
+func (s *graphQLServer) Transform(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		card := findPermanent(bs, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+		return transformCard(card)
+	})
+}
+
+// transformCard shows the card's next face.
+func transformCard(c *Card) error {
+	layout := ""
+	if c.Layout != nil {
+		layout = *c.Layout
+	}
+	current := 0
+	if c.FaceName != nil {
+		for i, f := range c.Faces {
+			if f.Name == *c.FaceName {
+				current = i
+				break
+			}
+		}
+	}
+	next, ok := cards.NextFace(layout, len(c.Faces), current)
+	if !ok {
+		return ErrBoardState.New("%s can't transform", c.Name)
+	}
+
+	face := c.Faces[next]
+	flipped := next != 0
+	c.Flipped = &flipped
+	c.FaceName = &face.Name
+	c.ManaCost = face.ManaCost
+	c.Cmc = face.Cmc
+	c.Colors = face.Colors
+	c.Power = face.Power
+	c.Toughness = face.Toughness
+	c.Loyalty = face.Loyalty
+	c.Types = face.Types
+	c.Subtypes = face.Subtypes
+	c.Supertypes = face.Supertypes
+	c.Text = face.Text
+	return nil
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// putBoard stores a board state for a test player in game "game", and adds
+// them to the game. The player owns every card on the board that doesn't
+// have an owner.
+func putBoard(t *testing.T, s *graphQLServer, username string, bs *BoardState) {
+	bs.GameID = "game"
+	bs.User = &User{Username: username}
+	assignOwners(bs)
+	assert.NoError(t, s.Set(BoardStateKey("game", username), bs))
+
+	game, ok := s.Directory["game"]
+	if !ok {
+		game = &Game{ID: "game"}
+		s.Directory["game"] = game
+	}
+	for _, u := range game.PlayerIDs {
+		if u.Username == username {
+			return
+		}
+	}
+	game.PlayerIDs = append(game.PlayerIDs, bs.User)
+}
+
+// testCard resolves a card from the test card database.
+func testCard(t *testing.T, s *graphQLServer, name string) *Card {
+	res, err := s.cards.Resolve(name)
+	assert.NoError(t, err)
+	assert.True(t, res.Resolved(), name)
+	card := cardFromModel(res.Printings[0])
+	card.InstanceID = newInstanceID()
+	return card
+}
+
+func TestTransform(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	delver := testCard(t, s, "Delver of Secrets")
+	bushi := testCard(t, s, "Bushi Tenderfoot")
+	expansion := testCard(t, s, "Expansion")
+	putBoard(t, s, "alice", &BoardState{
+		Field: []*Card{delver, bushi},
+		Hand:  []*Card{expansion},
+	})
+
+	t.Run("test transforms double-faced cards", func(t *testing.T) {
+		bs, err := s.Transform(ctx, "game", "alice", *delver.InstanceID)
+		assert.NoError(t, err)
+		card := findCard(bs.Field, *delver.InstanceID)
+		assert.Equal(t, "Delver of Secrets // Insectile Aberration", card.Name)
+		assert.Equal(t, "Insectile Aberration", *card.FaceName)
+		assert.True(t, *card.Flipped)
+		assert.Equal(t, "3", *card.Power)
+		assert.Equal(t, "2", *card.Toughness)
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, "Insectile Aberration", *findCard(stored.Field, *delver.InstanceID).FaceName)
+
+		bs, err = s.Transform(ctx, "game", "alice", *delver.InstanceID)
+		assert.NoError(t, err)
+		card = findCard(bs.Field, *delver.InstanceID)
+		assert.Equal(t, "Delver of Secrets", *card.FaceName)
+		assert.False(t, *card.Flipped)
+		assert.Equal(t, "1", *card.Power)
+	})
+
+	t.Run("test flips flip cards", func(t *testing.T) {
+		bs, err := s.Transform(ctx, "game", "alice", *bushi.InstanceID)
+		assert.NoError(t, err)
+		card := findCard(bs.Field, *bushi.InstanceID)
+		assert.Equal(t, "Kenzo the Hardhearted", *card.FaceName)
+		assert.Equal(t, "Legendary", *card.Supertypes)
+	})
+
+	t.Run("test transforms into planeswalkers", func(t *testing.T) {
+		nissa := &Card{
+			Name:       "Nissa, Vastwood Seer // Nissa, Sage Animist",
+			InstanceID: str("nissa"),
+			Layout:     str("transform"),
+			Cmc:        str("3"),
+			Power:      str("2"),
+			Toughness:  str("2"),
+			Types:      str("Creature"),
+			Faces: []*CardFace{
+				{Name: "Nissa, Vastwood Seer", Cmc: str("3"), Power: str("2"), Toughness: str("2"), Types: str("Creature")},
+				{Name: "Nissa, Sage Animist", Cmc: str("0"), Loyalty: str("3"), Types: str("Planeswalker")},
+			},
+		}
+		_, err := s.updateBoardState("game", "alice", func(bs *BoardState) error {
+			bs.Field = append(bs.Field, nissa)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bs, err := s.Transform(ctx, "game", "alice", "nissa")
+		assert.NoError(t, err)
+		card := findCard(bs.Field, "nissa")
+		assert.Equal(t, "3", *card.Loyalty)
+		assert.Equal(t, "0", *card.Cmc)
+		assert.Nil(t, card.Power)
+
+		bs, err = s.Transform(ctx, "game", "alice", "nissa")
+		assert.NoError(t, err)
+		card = findCard(bs.Field, "nissa")
+		assert.Nil(t, card.Loyalty)
+		assert.Equal(t, "3", *card.Cmc)
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		_, err := s.Transform(ctx, "game", "alice", *expansion.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+
+		putBoard(t, s, "bob", &BoardState{Field: []*Card{expansion}})
+		_, err = s.Transform(ctx, "game", "bob", *expansion.InstanceID)
+		assert.EqualError(t, err, "board state: Expansion // Explosion can't transform")
+
+		_, err = s.Transform(ctx, "game", "carol", *delver.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+}
+
+func TestAssignInstanceIDs(t *testing.T) {
+	bs := &BoardState{
+		Library: []*Card{{ID: "1", InstanceID: str("a")}, {ID: "1"}},
+		Hand:    []*Card{{ID: "1", InstanceID: str("a")}, {ID: "2", InstanceID: str("")}},
+		Field:   []*Card{{ID: "3", InstanceID: str("b")}},
+	}
+	assignInstanceIDs(bs)
+
+	assert.Equal(t, "a", *bs.Library[0].InstanceID)
+	assert.Equal(t, "b", *bs.Field[0].InstanceID)
+	seen := map[string]bool{}
+	for _, zone := range zones(bs) {
+		for _, c := range zone {
+			assert.NotEmpty(t, *c.InstanceID)
+			assert.False(t, seen[*c.InstanceID], "duplicate instance ID %s", *c.InstanceID)
+			seen[*c.InstanceID] = true
+		}
+	}
+}
@@ -2,217 +2,270 @@ package server
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"math/rand"
 	"strconv"
+	"strings"
 
-	"github.com/jmoiron/sqlx"
 	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
 )
 
+// Card returns the printings of the named card, with the default printing
+// first. `id` selects one printing by its MTGJSON uuid or card database id,
+// and `set` and `number` select one by set code and collector number.
 func (s *graphQLServer) Card(
 	ctx context.Context,
 	name string,
 	id *string,
+	set *string,
+	number *string,
 ) ([]*Card, error) {
-	if name == "" {
-		return nil, errs.New("must provide name for card")
-	}
-	rows, err := s.cardDB.Query(`SELECT "id", "name", "colors", "colorIdentity",
-		"convertedManaCost", "manaCost", "uuid", "power", "toughness", "types",
-		"subtypes", "supertypes", "isTextless", "text", "tcgplayerProductId", 
-		"scryfallIllustrationId" FROM "cards" WHERE "name" = ?`, name)
-	if err != nil {
-		return nil, errs.New("failed to run query: %s", err)
-	}
-
-	cards := []*Card{}
-	for rows.Next() {
-		var (
-			id                     *int
-			name                   *string
-			colors                 *string
-			colorIdentity          *string
-			convertedManaCost      *string
-			manaCost               *string
-			uuid                   *string
-			power                  *string
-			toughness              *string
-			types                  *string
-			subtypes               *string
-			supertypes             *string
-			isTextless             *int
-			text                   *string
-			tcgplayerProductID     *int
-			scryfallIllustrationID *string
-		)
-
-		if err := rows.Scan(&id, &name, &colors, &colorIdentity,
-			&convertedManaCost, &manaCost, &uuid, &power, &toughness, &types,
-			&subtypes, &supertypes, &isTextless, &text,
-			&tcgplayerProductID, &scryfallIllustrationID); err != nil {
-			log.Printf("error scanning rows for card query: %s", err)
-			continue
+	if id == nil && set == nil && number == nil {
+		printings, err := s.cards.ByName(name)
+		if err != nil {
+			return nil, errs.Wrap(err)
 		}
+		return cardsFromModels(printings), nil
+	}
 
-		parsedID := strconv.Itoa(*id)
-
-		card := &Card{
-			ID:            parsedID,
-			Name:          *name,
-			Colors:        colors,
-			ColorIdentity: colorIdentity,
-			Cmc:           convertedManaCost,
-			ManaCost:      manaCost,
-			UUID:          uuid,
-			Power:         power,
-			Toughness:     toughness,
-			Types:         types,
-			Subtypes:      subtypes,
-			Supertypes:    supertypes,
-			Text:          text,
-			ScryfallID:    scryfallIllustrationID,
-		}
-		cards = append(cards, card)
+	printing, err := s.printing(name, id, set, number)
+	if err != nil {
+		return nil, err
 	}
-	// TODO: return card with given id if *id is passed to args
+	return []*Card{cardFromModel(printing)}, nil
+}
 
-	if id != nil {
-		for _, c := range cards {
-			if c.ID == *id {
-				return []*Card{c}, nil
+// printing looks up the printing of a card selected by an id, which is an
+// MTGJSON uuid or a card database id, or by a set code and collector number.
+func (s *graphQLServer) printing(name string, id, set, number *string) (cards.Card, error) {
+	p := cards.Printing{}
+	if set != nil {
+		p.SetCode = *set
+	}
+	if number != nil {
+		p.Number = *number
+	}
+	if id != nil && *id != "" {
+		if _, err := strconv.Atoi(*id); err != nil {
+			p.UUID = *id
+		} else {
+			printings, err := s.cards.ByName(name)
+			if err != nil {
+				return cards.Card{}, errs.Wrap(err)
 			}
+			for _, c := range printings {
+				if strconv.Itoa(c.ID) == *id {
+					return c, nil
+				}
+			}
+			return cards.Card{}, cards.ErrPrintingNotFound.New("%s has no printing %s", name, *id)
 		}
 	}
-
-	return cards, err
+	return s.cards.Printing(name, p)
 }
 
+// Cards looks up a list of card names, returning the default printing of
+// each in the same order, so a name listed twice comes back twice. Names of
+// cards with more than one face can be the full `A // B` name or either
+// face, and names that don't match any card are left out.
 func (s *graphQLServer) Cards(ctx context.Context, list []string) ([]*Card, error) {
-	// TODO: Process `list` to allow for split cards
-	query, args, err := sqlx.In(`SELECT "id", "name", "colors", "colorIdentity",
-		"convertedManaCost", "manaCost", "uuid", "power", "toughness", "types",
-		"subtypes", "supertypes", "isTextless", "text", "tcgplayerProductId" FROM cards WHERE name IN (?);`, list)
-	if err != nil {
-		return nil, errs.New("error formatting sqlx query")
+	entries := []cards.DeckEntry{}
+	for _, name := range list {
+		entries = append(entries, cards.DeckEntry{Quantity: 1, Name: name})
 	}
-
-	rows, err := s.cardDB.Query(query, args...)
+	hydrated, err := s.cards.Hydrate(entries)
 	if err != nil {
-		return nil, errs.New("error querying cards DB for list of cards")
-	}
-
-	cards := []*Card{}
-
-	for rows.Next() {
-		var (
-			id                 *int
-			name               *string
-			colors             *string
-			colorIdentity      *string
-			convertedManaCost  *string
-			manaCost           *string
-			uuid               *string
-			power              *string
-			toughness          *string
-			types              *string
-			subtypes           *string
-			supertypes         *string
-			isTextless         *int
-			text               *string
-			tcgplayerProductId *int
-		)
-
-		if err := rows.Scan(&id, &name, &colors, &colorIdentity,
-			&convertedManaCost, &manaCost, &uuid, &power, &toughness, &types,
-			&subtypes, &supertypes, &isTextless, &text,
-			&tcgplayerProductId); err != nil {
-			log.Printf("error scanning rows for card query: %s", err)
-			continue
-		}
+		return nil, errs.New("error querying cards DB for list of cards: %s", err)
+	}
 
-		parsedID := strconv.Itoa(*id)
-
-		card := &Card{
-			ID:            parsedID,
-			Name:          *name,
-			Colors:        colors,
-			ColorIdentity: colorIdentity,
-			Cmc:           convertedManaCost,
-			ManaCost:      manaCost,
-			UUID:          uuid,
-			Power:         power,
-			Toughness:     toughness,
-			Types:         types,
-			Subtypes:      subtypes,
-			Supertypes:    supertypes,
-			Text:          text,
+	out := []*Card{}
+	for _, h := range hydrated {
+		if h.Hydrated() {
+			out = append(out, cardFromModel(h.Card))
 		}
-		cards = append(cards, card)
 	}
-
-	defer rows.Close()
-
-	return cards, nil
+	return out, nil
 }
 
-// Search will search for card names in the database.
+// Search will search for cards in the database. Every argument is optional
+// and cards must match all of the arguments given. `query` matches words in
+// a card's name, type line, or oracle text, and results are ranked by how
+// well they match when the card database has a full-text index. `colors`
+// matches cards with at least those colors, while `colorIdentity` matches
+// cards that fit in a deck of that color identity. A search with no
+// arguments returns nothing.
 func (s *graphQLServer) Search(
 	ctx context.Context,
+	query *string,
 	name *string,
 	colors []*string,
 	colorIdentity []*string,
 	keywords []*string,
+	types []*string,
+	text []*string,
+	cmc *InputRange,
+	power *InputRange,
+	toughness *InputRange,
+	rarity []*string,
+	set []*string,
+	limit *int,
+	offset *int,
 ) ([]*Card, error) {
-	if *name == "" {
+	filter := cards.Filter{
+		Keywords:  strs(keywords),
+		Types:     strs(types),
+		Text:      strs(text),
+		CMC:       comparisons(cmc),
+		Power:     comparisons(power),
+		Toughness: comparisons(toughness),
+		Rarities:  strs(rarity),
+		Sets:      strs(set),
+	}
+	if query != nil {
+		filter.Query = *query
+	}
+	if name != nil {
+		filter.Name = *name
+	}
+	if colors != nil {
+		filter.Colors = &cards.ColorFilter{Colors: strs(colors), Match: cards.ColorsInclude}
+	}
+	if colorIdentity != nil {
+		filter.ColorIdentity = &cards.ColorFilter{Colors: strs(colorIdentity), Match: cards.ColorsWithin}
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+
+	if filter.IsEmpty() {
 		return nil, nil
 	}
-	n := fmt.Sprintf("%%%s%%", *name)
-	rows, err := s.cardDB.Query("SELECT id, name, colors FROM cards WHERE name LIKE ?", n)
+
+	printings, err := s.cards.Search(filter)
 	if err != nil {
-		log.Printf("error querying database: %s", err)
 		return nil, errs.New("failed to search cardDB: %s", err)
 	}
 
-	cards := []*Card{}
-
-	for rows.Next() {
-		var (
-			id     *int
-			name   *string
-			colors *string
-		)
+	return cardsFromModels(printings), nil
+}
 
-		if err := rows.Scan(&id, &name, &colors); err != nil {
-			log.Printf("ERROR: failed to scan card into struct: %s", err)
-			continue
+// strs drops nulls and empty strings from a GraphQL list of strings.
+func strs(list []*string) []string {
+	out := []string{}
+	for _, s := range list {
+		if s != nil && *s != "" {
+			out = append(out, *s)
 		}
+	}
+	return out
+}
+
+// comparisons turns an InputRange into an inclusive range filter.
+func comparisons(r *InputRange) []cards.Comparison {
+	out := []cards.Comparison{}
+	if r == nil {
+		return out
+	}
+	if r.Min != nil {
+		out = append(out, cards.Comparison{Op: ">=", Value: *r.Min})
+	}
+	if r.Max != nil {
+		out = append(out, cards.Comparison{Op: "<=", Value: *r.Max})
+	}
+	return out
+}
 
-		parsedID := strconv.Itoa(*id)
-		card := &Card{
-			ID:     parsedID,
-			Name:   *name,
-			Colors: colors,
+// ResolveCards matches names from a decklist to cards, tolerating case,
+// accents, split and double-faced card halves, and small typos. Names that
+// can't be matched come back with suggestions so that the player can be
+// asked what they meant.
+func (s *graphQLServer) ResolveCards(ctx context.Context, names []string) ([]*CardResolution, error) {
+	out := []*CardResolution{}
+	for _, name := range names {
+		res, err := s.cards.Resolve(name)
+		if err != nil {
+			return nil, errs.Wrap(err)
 		}
 
-		cards = append(cards, card)
+		resolution := &CardResolution{
+			Query:       res.Query,
+			Match:       string(res.Match),
+			Suggestions: res.Suggestions,
+		}
+		if res.Resolved() {
+			resolution.Card = cardFromModel(res.Printings[0])
+		}
+		out = append(out, resolution)
 	}
+	return out, nil
+}
 
-	return cards, nil
+// cardFromModel maps a card database printing to the GraphQL Card type.
+func cardFromModel(c cards.Card) *Card {
+	card := &Card{
+		ID:            strconv.Itoa(c.ID),
+		Name:          c.Name,
+		Colors:        strPtr(strings.Join(c.Colors, ",")),
+		ColorIdentity: strPtr(strings.Join(c.ColorIdentity, ",")),
+		Cmc:           strPtr(c.CMCString()),
+		ManaCost:      strPtr(c.ManaCost),
+		UUID:          strPtr(c.UUID),
+		Power:         optionalStr(c.Power),
+		Toughness:     optionalStr(c.Toughness),
+		Loyalty:       optionalStr(c.Loyalty),
+		Types:         strPtr(strings.Join(c.Types, ",")),
+		Subtypes:      strPtr(strings.Join(c.Subtypes, ",")),
+		Supertypes:    strPtr(strings.Join(c.Supertypes, ",")),
+		IsTextless:    strPtr(strconv.FormatBool(c.IsTextless)),
+		Text:          strPtr(c.Text),
+		ScryfallID:    optionalStr(c.ScryfallIllustrationID),
+		Layout:        optionalStr(c.Layout),
+		FaceName:      optionalStr(c.FaceName),
+		Set:           optionalStr(c.SetCode),
+		Number:        optionalStr(c.Number),
+	}
+	if c.TCGPlayerProductID != 0 {
+		card.Tcgid = strPtr(strconv.Itoa(c.TCGPlayerProductID))
+	}
+	for _, f := range c.Faces {
+		card.Faces = append(card.Faces, &CardFace{
+			Name:       f.Name,
+			ManaCost:   optionalStr(f.ManaCost),
+			Cmc:        strPtr(strconv.FormatFloat(f.CMC, 'f', -1, 64)),
+			Colors:     strPtr(strings.Join(f.Colors, ",")),
+			Power:      optionalStr(f.Power),
+			Toughness:  optionalStr(f.Toughness),
+			Loyalty:    optionalStr(f.Loyalty),
+			Types:      strPtr(strings.Join(f.Types, ",")),
+			Subtypes:   strPtr(strings.Join(f.Subtypes, ",")),
+			Supertypes: strPtr(strings.Join(f.Supertypes, ",")),
+			Text:       optionalStr(f.Text),
+		})
+	}
+	return card
 }
 
-//
-// Shuffle functions
-//
+// cardsFromModels maps a list of card database printings to GraphQL Cards.
+func cardsFromModels(printings []cards.Card) []*Card {
+	out := []*Card{}
+	for _, c := range printings {
+		out = append(out, cardFromModel(c))
+	}
+	return out
+}
 
-// Shuffler type defines the interface for a given Shuffle function to fulfill.
-type Shuffler func(deck []*Card) ([]*Card, error)
+func strPtr(s string) *string {
+	return &s
+}
 
-// Shuffle will apply a Knuth shuffle to the decklist.
-func Shuffle(deck []*Card) ([]*Card, error) {
-	rand.Shuffle(len(deck), func(i, j int) {
-		deck[i], deck[j] = deck[j], deck[i]
-	})
-	return deck, nil
+// optionalStr returns nil for an empty string so that GraphQL reports it
+// as null.
+func optionalStr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
@@ -0,0 +1,433 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DeclareAttackers declares which of the player's creatures attack, and
+// which player or planeswalker each attacks. It replaces any attackers the
+// player declared before, untapping the ones that declaration tapped.
+// Attacking creatures are tapped, except for those with vigilance.
+func (s *graphQLServer) DeclareAttackers(ctx context.Context, gameID string, username string, attackers []*InputAttacker) (*BoardState, error) {
+	var (
+		updated *BoardState
+		events  []*GameEvent
+	)
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		for _, c := range battlefield(updated) {
+			if c.Attacking != nil && !hasKeyword(c, "Vigilance") {
+				c.Tapped = nil
+			}
+			c.Attacking = nil
+		}
+
+		for _, a := range attackers {
+			card := findPermanent(updated, a.CardID)
+			if card == nil {
+				return ErrBoardState.New("card %s is not on the battlefield", a.CardID)
+			}
+			if !hasType(card, "Creature") {
+				return ErrBoardState.New("%s isn't a creature", publicName(card))
+			}
+			if isTapped(card) {
+				return ErrBoardState.New("%s is tapped", publicName(card))
+			}
+
+			defender := a.Defender
+			if _, ok := boards[defender]; ok {
+				if defender == username {
+					return ErrBoardState.New("%s can't attack its controller", publicName(card))
+				}
+			} else {
+				pw, controller := findPermanentInGame(boards, defender)
+				if pw == nil || !hasType(pw, "Planeswalker") {
+					return ErrBoardState.New("%s is not a player or planeswalker in the game", defender)
+				}
+				if controller == username {
+					return ErrBoardState.New("%s can't attack a planeswalker its controller controls", publicName(card))
+				}
+				defender = publicName(pw)
+			}
+
+			card.Attacking = &a.Defender
+			if !hasKeyword(card, "Vigilance") {
+				tapped := true
+				card.Tapped = &tapped
+			}
+			events = append(events, newEvent(gameID, "attack", username, "%s attacks %s with %s", username, defender, publicName(card)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(events...)
+}
+
+// DeclareBlockers declares which of the player's creatures block, and which
+// attacking creature each blocks. A creature that can block more than one
+// attacker is listed once per attacker. It replaces any blockers the player
+// declared before.
+func (s *graphQLServer) DeclareBlockers(ctx context.Context, gameID string, username string, blockers []*InputBlocker) (*BoardState, error) {
+	var (
+		updated *BoardState
+		events  []*GameEvent
+	)
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		for _, c := range battlefield(updated) {
+			c.Blocking = nil
+		}
+
+		for _, b := range blockers {
+			card := findPermanent(updated, b.CardID)
+			if card == nil {
+				return ErrBoardState.New("card %s is not on the battlefield", b.CardID)
+			}
+			if !hasType(card, "Creature") {
+				return ErrBoardState.New("%s isn't a creature", publicName(card))
+			}
+			if isTapped(card) {
+				return ErrBoardState.New("%s is tapped", publicName(card))
+			}
+			attacker, _ := findPermanentInGame(boards, b.AttackerID)
+			if attacker == nil || attacker.Attacking == nil {
+				return ErrBoardState.New("card %s is not attacking", b.AttackerID)
+			}
+			if !defends(boards, username, *attacker.Attacking) {
+				return ErrBoardState.New("%s isn't attacking %s", publicName(attacker), username)
+			}
+
+			card.Blocking = append(card.Blocking, b.AttackerID)
+			events = append(events, newEvent(gameID, "block", username, "%s blocks %s with %s", username, publicName(attacker), publicName(card)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(events...)
+}
+
+// CombatDamage deals combat damage for the player's attacking creatures.
+// Unblocked creatures deal damage to the player or planeswalker they
+// attack, and damage from a commander is added to the player's commander
+// damage. Dealing combat damage to the monarch, or to the player with the
+// initiative, takes it from them. A blocked creature deals lethal damage
+// to each of its blockers in the order they're on the battlefield, with the
+// rest going to the last one, and blockers deal damage to the creature they
+// block. Creatures with lethal damage are marked with LethalDamage for
+// their controller to put into the graveyard. First strike, trample and
+// other abilities are left to the players.
+//
+// Combat ends once damage is dealt, so every attacker and blocker is
+// removed from combat. Marked damage stays until the turn passes.
+func (s *graphQLServer) CombatDamage(ctx context.Context, gameID string, username string) (*CombatDamage, error) {
+	out := &CombatDamage{Damage: []*DamageEvent{}, Boards: []*BoardState{}}
+	var events []*GameEvent
+	_, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		attackingBoard := boards[username]
+		if attackingBoard == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+
+		deal := func(source *Card, target string, targetName string, amount int) {
+			if amount <= 0 {
+				return
+			}
+			d := &DamageEvent{
+				Source:     instanceID(source),
+				SourceName: publicName(source),
+				Target:     target,
+				TargetName: targetName,
+				Amount:     amount,
+				Commander:  isCommander(source),
+			}
+			out.Damage = append(out.Damage, d)
+			events = append(events, newEvent(gameID, "damage", username, "%s deals %d damage to %s", publicName(source), amount, targetName))
+		}
+
+		for _, attacker := range battlefield(attackingBoard) {
+			if attacker.Attacking == nil {
+				continue
+			}
+			blockers := blockersOf(boards, instanceID(attacker))
+
+			amount := power(attacker)
+			if len(blockers) == 0 {
+				if amount <= 0 {
+					continue
+				}
+				if defender, ok := boards[*attacker.Attacking]; ok {
+					defender.Life -= amount
+					deal(attacker, *attacker.Attacking, *attacker.Attacking, amount)
+					if isCommander(attacker) {
+						addCommanderDamage(defender, attacker, amount)
+					}
+					for _, name := range []string{monarch, initiative} {
+						if holds(game, name, *attacker.Attacking) {
+							events = append(events, giveExclusive(game, name, username)...)
+						}
+					}
+				} else if pw, _ := findPermanentInGame(boards, *attacker.Attacking); pw != nil {
+					removeLoyalty(pw, amount)
+					deal(attacker, instanceID(pw), publicName(pw), amount)
+				}
+				continue
+			}
+
+			remaining := amount
+			for i, blocker := range blockers {
+				amount := toughness(blocker) - damage(blocker)
+				if amount < 0 {
+					amount = 0
+				}
+				if amount > remaining || i == len(blockers)-1 {
+					amount = remaining
+				}
+				markDamage(blocker, amount)
+				deal(attacker, instanceID(blocker), publicName(blocker), amount)
+				remaining -= amount
+			}
+		}
+
+		// blockers deal their damage to the first attacker they block
+		for _, bs := range boards {
+			for _, blocker := range battlefield(bs) {
+				if len(blocker.Blocking) == 0 {
+					continue
+				}
+				attacker, _ := findPermanentInGame(boards, blocker.Blocking[0])
+				if attacker == nil {
+					continue
+				}
+				amount := power(blocker)
+				markDamage(attacker, amount)
+				deal(blocker, instanceID(attacker), publicName(attacker), amount)
+			}
+		}
+
+		for _, username := range sortedPlayers(boards) {
+			bs := boards[username]
+			for _, c := range battlefield(bs) {
+				c.Attacking = nil
+				c.Blocking = nil
+				if damage(c) > 0 && damage(c) >= toughness(c) && hasType(c, "Creature") {
+					lethal := true
+					c.LethalDamage = &lethal
+				}
+			}
+			out.Boards = append(out.Boards, bs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, bs := range out.Boards {
+		out.Boards[i] = visibleTo(bs, username)
+	}
+	return out, s.logEvents(events...)
+}
+
+// findPermanentInGame returns the permanent with the given instance ID on
+// any battlefield in the game, and its controller, or nil.
+func findPermanentInGame(boards map[string]*BoardState, instanceID string) (*Card, string) {
+	for username, bs := range boards {
+		if c := findPermanent(bs, instanceID); c != nil {
+			return c, username
+		}
+	}
+	return nil, ""
+}
+
+// defends returns true if the player is being attacked through attacking,
+// which is either their username or the instance ID of a planeswalker they
+// control.
+func defends(boards map[string]*BoardState, username, attacking string) bool {
+	if attacking == username {
+		return true
+	}
+	bs, ok := boards[username]
+	return ok && findPermanent(bs, attacking) != nil
+}
+
+// blockersOf returns every creature blocking the attacker, in the order
+// they're on their controller's battlefield.
+func blockersOf(boards map[string]*BoardState, attackerID string) []*Card {
+	var out []*Card
+	for _, username := range sortedPlayers(boards) {
+		for _, c := range battlefield(boards[username]) {
+			for _, id := range c.Blocking {
+				if id == attackerID {
+					out = append(out, c)
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// sortedPlayers returns the usernames of the players with boards, sorted.
+func sortedPlayers(boards map[string]*BoardState) []string {
+	players := make([]string, 0, len(boards))
+	for username := range boards {
+		players = append(players, username)
+	}
+	sort.Strings(players)
+	return players
+}
+
+// addCommanderDamage adds damage dealt by a commander to a player's
+// commander damage.
+func addCommanderDamage(bs *BoardState, commander *Card, amount int) {
+	id := instanceID(commander)
+	for _, cd := range bs.CommanderDamage {
+		if cd.Commander == id {
+			cd.Amount += amount
+			return
+		}
+	}
+	bs.CommanderDamage = append(bs.CommanderDamage, &CommanderDamage{
+		Commander: id,
+		Name:      publicName(commander),
+		Amount:    amount,
+	})
+}
+
+// removeLoyalty removes loyalty counters from a planeswalker that was dealt
+// damage.
+func removeLoyalty(c *Card, amount int) {
+	counter := findCounter(c, "loyalty")
+	if counter == nil {
+		return
+	}
+	have, err := counterValue(counter)
+	if err != nil {
+		return
+	}
+	if have -= amount; have < 0 {
+		have = 0
+	}
+	counter.Value = strconv.Itoa(have)
+}
+
+// markDamage marks damage dealt to a creature.
+func markDamage(c *Card, amount int) {
+	if amount <= 0 {
+		return
+	}
+	total := damage(c) + amount
+	c.Damage = &total
+}
+
+// damage returns the damage marked on a creature.
+func damage(c *Card) int {
+	if c.Damage == nil {
+		return 0
+	}
+	return *c.Damage
+}
+
+// power returns a creature's power, counting +1/+1 and -1/-1 counters.
+// Power that isn't a number, like *, counts as 0, and a face-down creature
+// is a 2/2.
+func power(c *Card) int {
+	if isFaceDown(c) {
+		return faceDownStat + ptCounters(c)
+	}
+	return stat(c.Power) + ptCounters(c)
+}
+
+// toughness returns a creature's toughness, counting +1/+1 and -1/-1
+// counters.
+func toughness(c *Card) int {
+	if isFaceDown(c) {
+		return faceDownStat + ptCounters(c)
+	}
+	return stat(c.Toughness) + ptCounters(c)
+}
+
+func stat(s *string) int {
+	if s == nil {
+		return 0
+	}
+	n, err := strconv.Atoi(*s)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// ptCounters returns how much a creature's +1/+1 and -1/-1 counters add to
+// its power and toughness.
+func ptCounters(c *Card) int {
+	n := 0
+	if counter := findCounter(c, "+1/+1"); counter != nil {
+		v, _ := counterValue(counter)
+		n += v
+	}
+	if counter := findCounter(c, "-1/-1"); counter != nil {
+		v, _ := counterValue(counter)
+		n -= v
+	}
+	return n
+}
+
+// hasType returns true if the card's types include t. A face-down card is
+// only a creature.
+func hasType(c *Card, t string) bool {
+	if isFaceDown(c) {
+		return t == "Creature"
+	}
+	return listContains(c.Types, t)
+}
+
+// listContains returns true if the comma separated list includes s.
+func listContains(list *string, s string) bool {
+	if list == nil {
+		return false
+	}
+	for _, item := range strings.Split(*list, ",") {
+		if strings.TrimSpace(item) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// hasKeyword returns true if the card's text gives it the keyword, written
+// on a line of its own or at the start of a line of keywords. A face-down
+// card has no abilities.
+func hasKeyword(c *Card, keyword string) bool {
+	if c.Text == nil || isFaceDown(c) {
+		return false
+	}
+	for _, line := range strings.Split(*c.Text, "\n") {
+		for _, k := range strings.Split(line, ",") {
+			if strings.EqualFold(strings.TrimSpace(k), keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isTapped(c *Card) bool {
+	return c.Tapped != nil && *c.Tapped
+}
+
+func isCommander(c *Card) bool {
+	return c.IsCommander != nil && *c.IsCommander
+}
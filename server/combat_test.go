@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombat(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	yes := true
+
+	angel := testCard(t, s, "Serra Angel")
+	angel.IsCommander = &yes
+	giant := testCard(t, s, "Bonecrusher Giant")
+	delver := testCard(t, s, "Delver of Secrets")
+	swamp := testCard(t, s, "Swamp")
+	teysa := testCard(t, s, "Teysa Karlov")
+	jace := &Card{
+		Name:       "Jace Beleren",
+		Types:      str("Planeswalker"),
+		InstanceID: newInstanceID(),
+		Counters:   []*Counter{{Name: "loyalty", Value: "3"}},
+	}
+	putBoard(t, s, "alice", &BoardState{Life: 40, Field: []*Card{angel, giant, delver, swamp}})
+	putBoard(t, s, "bob", &BoardState{Life: 40, Field: []*Card{teysa, jace}})
+	putBoard(t, s, "carol", &BoardState{Life: 40})
+
+	t.Run("test rejects bad attacks", func(t *testing.T) {
+		cases := []*InputAttacker{
+			{CardID: *angel.InstanceID, Defender: "alice"},
+			{CardID: *angel.InstanceID, Defender: "dave"},
+			{CardID: *swamp.InstanceID, Defender: "bob"},
+			{CardID: *teysa.InstanceID, Defender: "alice"},
+		}
+		for _, c := range cases {
+			_, err := s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{c})
+			assert.True(t, ErrBoardState.Has(err), c.Defender)
+		}
+	})
+
+	t.Run("test declares attackers", func(t *testing.T) {
+		bs, err := s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{
+			{CardID: *angel.InstanceID, Defender: "bob"},
+			{CardID: *giant.InstanceID, Defender: "bob"},
+			{CardID: *delver.InstanceID, Defender: *jace.InstanceID},
+		})
+		assert.NoError(t, err)
+		attacker := findCard(bs.Field, *giant.InstanceID)
+		assert.Equal(t, "bob", *attacker.Attacking)
+		assert.True(t, *attacker.Tapped)
+		assert.Nil(t, findCard(bs.Field, *angel.InstanceID).Tapped, "vigilance")
+	})
+
+	t.Run("test redeclares attackers", func(t *testing.T) {
+		bs, err := s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{
+			{CardID: *delver.InstanceID, Defender: "carol"},
+		})
+		assert.NoError(t, err)
+		giantCard := findCard(bs.Field, *giant.InstanceID)
+		assert.Nil(t, giantCard.Attacking)
+		assert.Nil(t, giantCard.Tapped, "the earlier declaration tapped it")
+		assert.Equal(t, "carol", *findCard(bs.Field, *delver.InstanceID).Attacking)
+
+		bs, err = s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{
+			{CardID: *angel.InstanceID, Defender: "bob"},
+			{CardID: *giant.InstanceID, Defender: "bob"},
+			{CardID: *delver.InstanceID, Defender: *jace.InstanceID},
+		})
+		assert.NoError(t, err)
+		assert.True(t, *findCard(bs.Field, *giant.InstanceID).Tapped)
+		assert.Equal(t, *jace.InstanceID, *findCard(bs.Field, *delver.InstanceID).Attacking)
+	})
+
+	t.Run("test declares blockers", func(t *testing.T) {
+		_, err := s.DeclareBlockers(ctx, "game", "carol", []*InputBlocker{{CardID: *teysa.InstanceID, AttackerID: *giant.InstanceID}})
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.DeclareBlockers(ctx, "game", "bob", []*InputBlocker{{CardID: *teysa.InstanceID, AttackerID: *swamp.InstanceID}})
+		assert.True(t, ErrBoardState.Has(err))
+
+		bs, err := s.DeclareBlockers(ctx, "game", "bob", []*InputBlocker{{CardID: *teysa.InstanceID, AttackerID: *giant.InstanceID}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{*giant.InstanceID}, findCard(bs.Field, *teysa.InstanceID).Blocking)
+	})
+
+	t.Run("test deals combat damage", func(t *testing.T) {
+		result, err := s.CombatDamage(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Len(t, result.Boards, 3)
+
+		dealt := map[string]int{}
+		for _, d := range result.Damage {
+			dealt[d.SourceName+" -> "+d.TargetName] = d.Amount
+		}
+		assert.Equal(t, map[string]int{
+			"Serra Angel -> bob":                                        4,
+			"Bonecrusher Giant // Stomp -> Teysa Karlov":                4,
+			"Delver of Secrets // Insectile Aberration -> Jace Beleren": 1,
+			"Teysa Karlov -> Bonecrusher Giant // Stomp":                2,
+		}, dealt)
+
+		bob, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, 36, bob.Life)
+		assert.Equal(t, []*CommanderDamage{{Commander: *angel.InstanceID, Name: "Serra Angel", Amount: 4}}, bob.CommanderDamage)
+		blocker := findCard(bob.Field, *teysa.InstanceID)
+		assert.Equal(t, 4, *blocker.Damage)
+		assert.True(t, *blocker.LethalDamage)
+		assert.Nil(t, blocker.Blocking)
+		assert.Equal(t, "2", findCard(bob.Field, *jace.InstanceID).Counters[0].Value)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		attacker := findCard(alice.Field, *giant.InstanceID)
+		assert.Equal(t, 2, *attacker.Damage)
+		assert.Nil(t, attacker.LethalDamage)
+		assert.Nil(t, attacker.Attacking)
+	})
+
+	t.Run("test logs combat to the table", func(t *testing.T) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		texts := []string{}
+		for _, e := range events {
+			texts = append(texts, e.Text)
+		}
+		assert.Contains(t, texts, "alice attacks Jace Beleren with Delver of Secrets // Insectile Aberration")
+		assert.Contains(t, texts, "bob blocks Bonecrusher Giant // Stomp with Teysa Karlov")
+		assert.Contains(t, texts, "Serra Angel deals 4 damage to bob")
+		assert.Equal(t, "attack", events[0].Kind)
+	})
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+)
+
+// GainControl moves a permanent from any player's battlefield in the game
+// onto the player's. A permanent the player doesn't own goes in their
+// Controlled zone. cardID is the permanent's instance ID.
+func (s *graphQLServer) GainControl(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		card := takePermanent(boards, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+		putPermanent(updated, card)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), nil
+}
+
+// ReturnControl moves a permanent from any player's battlefield in the game
+// back onto its owner's. cardID is the permanent's instance ID.
+func (s *graphQLServer) ReturnControl(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		card := takePermanent(boards, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+		ownerBoard, ok := boards[owner(card)]
+		if !ok {
+			return ErrBoardState.New("%s's owner %s is not in the game", card.Name, owner(card))
+		}
+		putPermanent(ownerBoard, card)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), nil
+}
+
+// takePermanent removes the permanent with the given instance ID from
+// whichever battlefield it's on, and returns it, or nil.
+func takePermanent(boards map[string]*BoardState, instanceID string) *Card {
+	for _, bs := range boards {
+		if c := takeCard([]*[]*Card{&bs.Field, &bs.Controlled}, instanceID); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// putPermanent puts a permanent onto the player's battlefield under their
+// control: onto their Field if they own it, and in Controlled otherwise.
+func putPermanent(bs *BoardState, c *Card) {
+	username := bs.User.Username
+	c.Controller = &username
+	if owner(c) == username {
+		bs.Field = append(bs.Field, c)
+	} else {
+		bs.Controlled = append(bs.Controlled, c)
+	}
+}
+
+// owner returns the username of a card's owner, or "" if it isn't known.
+func owner(c *Card) string {
+	if c.Owner == nil {
+		return ""
+	}
+	return *c.Owner
+}
+
+// assignOwners makes the player the owner of every card on their board
+// that doesn't have an owner yet.
+func assignOwners(bs *BoardState) {
+	username := bs.User.Username
+	for _, zone := range zones(bs) {
+		for _, c := range zone {
+			if c != nil && owner(c) == "" {
+				c.Owner = &username
+			}
+		}
+	}
+}
+
+// ownedZones returns the zones of a board that only hold cards the player
+// owns, in the same order for every board.
+func ownedZones(bs *BoardState) []*[]*Card {
+	return []*[]*Card{
+		&bs.Commander, &bs.Library, &bs.Graveyard,
+		&bs.Exiled, &bs.Hand, &bs.Revealed,
+	}
+}
+
+// settleControl keeps every card on the board of the player it belongs
+// to. A card that leaves the battlefield goes to the same zone of its
+// owner's board, so a stolen creature that dies goes to its owner's
+// graveyard. Permanents are in Field if their controller owns them and in
+// Controlled otherwise, and only permanents have a controller.
+func settleControl(boards map[string]*BoardState) {
+	for username, bs := range boards {
+		for i, zone := range ownedZones(bs) {
+			stay := (*zone)[:0]
+			for _, c := range *zone {
+				c.Controller = nil
+				ownerBoard, ok := boards[owner(c)]
+				if !ok || ownerBoard == bs {
+					stay = append(stay, c)
+					continue
+				}
+				dst := ownedZones(ownerBoard)[i]
+				*dst = append(*dst, c)
+			}
+			*zone = stay
+		}
+
+		controller := username
+		permanents := battlefield(bs)
+		bs.Field, bs.Controlled = bs.Field[:0], bs.Controlled[:0]
+		for _, c := range permanents {
+			c.Controller = &controller
+			if o := owner(c); o == "" || o == controller {
+				bs.Field = append(bs.Field, c)
+			} else {
+				bs.Controlled = append(bs.Controlled, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControl(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	teysa := testCard(t, s, "Teysa Karlov")
+	putBoard(t, s, "alice", &BoardState{Field: []*Card{angel}})
+	putBoard(t, s, "bob", &BoardState{Field: []*Card{teysa}})
+
+	t.Run("test gains control", func(t *testing.T) {
+		bs, err := s.GainControl(ctx, "game", "bob", *angel.InstanceID)
+		assert.NoError(t, err)
+		stolen := findCard(bs.Controlled, *angel.InstanceID)
+		if assert.NotNil(t, stolen) {
+			assert.Equal(t, "alice", *stolen.Owner)
+			assert.Equal(t, "bob", *stolen.Controller)
+		}
+		assert.Equal(t, "bob", *findCard(bs.Field, *teysa.InstanceID).Controller)
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Empty(t, stored.Field)
+
+		_, err = s.GainControl(ctx, "game", "bob", "missing")
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test returns control", func(t *testing.T) {
+		bs, err := s.ReturnControl(ctx, "game", "bob", *angel.InstanceID)
+		assert.NoError(t, err)
+		assert.Empty(t, bs.Controlled)
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		returned := findCard(stored.Field, *angel.InstanceID)
+		if assert.NotNil(t, returned) {
+			assert.Equal(t, "alice", *returned.Controller)
+		}
+	})
+
+	t.Run("test stolen cards go to their owner's zones", func(t *testing.T) {
+		_, err := s.GainControl(ctx, "game", "bob", *angel.InstanceID)
+		assert.NoError(t, err)
+		bs, err := s.updateBoardState("game", "bob", func(bs *BoardState) error {
+			bs.Graveyard = append(bs.Graveyard, bs.Controlled...)
+			bs.Controlled = nil
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, bs.Graveyard)
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		dead := findCard(stored.Graveyard, *angel.InstanceID)
+		if assert.NotNil(t, dead) {
+			assert.Nil(t, dead.Controller)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"strconv"
+)
+
+// findCardOnBoard returns the card with the given instance ID in any of the
+// board's zones, or nil.
+func findCardOnBoard(bs *BoardState, instanceID string) *Card {
+	for _, zone := range zones(bs) {
+		if c := findCard(zone, instanceID); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// AddCounter puts amount counters of the named kind, like "+1/+1" or
+// "loyalty", on one of the player's cards. assignedBy is the player who
+// put them there, and defaults to the player adding them.
+func (s *graphQLServer) AddCounter(ctx context.Context, gameID string, username string, cardID string, name string, amount *int, assignedBy *string) (*BoardState, error) {
+	n := amountOrOne(amount)
+	if n < 1 {
+		return nil, ErrBoardState.New("can't add %d counters", n)
+	}
+	if assignedBy == nil || *assignedBy == "" {
+		assignedBy = &username
+	}
+
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		card := findCardOnBoard(bs, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s not found", cardID)
+		}
+		counter := findCounter(card, name)
+		if counter == nil {
+			card.Counters = append(card.Counters, &Counter{
+				Name:       name,
+				Value:      strconv.Itoa(n),
+				AssignedBy: assignedBy,
+			})
+			return nil
+		}
+		have, err := counterValue(counter)
+		if err != nil {
+			return err
+		}
+		counter.Value = strconv.Itoa(have + n)
+		counter.AssignedBy = assignedBy
+		return nil
+	})
+}
+
+// RemoveCounter takes amount counters of the named kind off one of the
+// player's cards. The counter is removed from the card once none are left.
+func (s *graphQLServer) RemoveCounter(ctx context.Context, gameID string, username string, cardID string, name string, amount *int) (*BoardState, error) {
+	n := amountOrOne(amount)
+	if n < 1 {
+		return nil, ErrBoardState.New("can't remove %d counters", n)
+	}
+
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		card := findCardOnBoard(bs, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s not found", cardID)
+		}
+		counter := findCounter(card, name)
+		if counter == nil {
+			return ErrBoardState.New("%s has no %s counters", card.Name, name)
+		}
+		have, err := counterValue(counter)
+		if err != nil {
+			return err
+		}
+		if n < have {
+			counter.Value = strconv.Itoa(have - n)
+			return nil
+		}
+		// a card can't have fewer than zero counters
+		for i, c := range card.Counters {
+			if c == counter {
+				card.Counters = append(card.Counters[:i], card.Counters[i+1:]...)
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// findCounter returns the card's counter of the named kind, or nil.
+func findCounter(card *Card, name string) *Counter {
+	for _, c := range card.Counters {
+		if c != nil && c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// counterValue returns how many counters a Counter holds.
+func counterValue(c *Counter) (int, error) {
+	n, err := strconv.Atoi(c.Value)
+	if err != nil {
+		return 0, ErrBoardState.New("%s counter has value %q, not a number", c.Name, c.Value)
+	}
+	return n, nil
+}
+
+// countersFromInput converts a card's InputCounters to Counters.
+func countersFromInput(in []*InputCounter) []*Counter {
+	var out []*Counter
+	for _, c := range in {
+		if c == nil {
+			continue
+		}
+		out = append(out, &Counter{
+			Name:       c.Name,
+			Value:      c.Value,
+			AssignedBy: c.AssignedBy,
+		})
+	}
+	return out
+}
+
+// labelsFromInput converts a card's InputLabels to Labels.
+func labelsFromInput(in []*InputLabel) []*Label {
+	var out []*Label
+	for _, l := range in {
+		if l == nil {
+			continue
+		}
+		label := Label(*l)
+		out = append(out, &label)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounters(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	bob := "bob"
+
+	angel := testCard(t, s, "Serra Angel")
+	swamp := testCard(t, s, "Swamp")
+	stolen := testCard(t, s, "Teysa Karlov")
+	carol := "carol"
+	stolen.Owner = &carol
+	putBoard(t, s, "alice", &BoardState{
+		Field:      []*Card{angel},
+		Exiled:     []*Card{swamp},
+		Controlled: []*Card{stolen},
+	})
+
+	t.Run("test adds counters", func(t *testing.T) {
+		bs, err := s.AddCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", nil, nil)
+		assert.NoError(t, err)
+		bs, err = s.AddCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", intPtr(2), &bob)
+		assert.NoError(t, err)
+		card := findCard(bs.Field, *angel.InstanceID)
+		assert.Equal(t, []*Counter{{Name: "+1/+1", Value: "3", AssignedBy: &bob}}, card.Counters)
+
+		bs, err = s.AddCounter(ctx, "game", "alice", *swamp.InstanceID, "time", intPtr(4), nil)
+		assert.NoError(t, err)
+		card = findCard(bs.Exiled, *swamp.InstanceID)
+		assert.Equal(t, "4", card.Counters[0].Value)
+		assert.Equal(t, "alice", *card.Counters[0].AssignedBy)
+
+		bs, err = s.AddCounter(ctx, "game", "alice", *stolen.InstanceID, "+1/+1", nil, nil)
+		assert.NoError(t, err)
+		card = findCard(bs.Controlled, *stolen.InstanceID)
+		assert.Equal(t, "alice", *card.Counters[0].AssignedBy, "defaults to the player adding them, not the owner")
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", findCard(stored.Field, *angel.InstanceID).Counters[0].Value)
+	})
+
+	t.Run("test removes counters", func(t *testing.T) {
+		bs, err := s.RemoveCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", findCard(bs.Field, *angel.InstanceID).Counters[0].Value)
+
+		bs, err = s.RemoveCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", intPtr(5))
+		assert.NoError(t, err)
+		assert.Empty(t, findCard(bs.Field, *angel.InstanceID).Counters)
+
+		_, err = s.RemoveCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", nil)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test rejects bad requests", func(t *testing.T) {
+		_, err := s.AddCounter(ctx, "game", "alice", "missing", "+1/+1", nil, nil)
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.AddCounter(ctx, "game", "alice", *angel.InstanceID, "+1/+1", intPtr(0), nil)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+}
+
+func TestCountersFromInput(t *testing.T) {
+	alice := "alice"
+	bs := boardStateFromInput(InputBoardState{
+		User:   &InputUser{Username: "alice"},
+		GameID: "game",
+		Field: []*InputCard{{
+			Name:     "Serra Angel",
+			Counters: []*InputCounter{{Name: "+1/+1", Value: "2", AssignedBy: &alice}},
+			Labels:   []*InputLabel{{Name: "note", Value: "stolen", AssignedBy: "bob"}},
+		}},
+		Counters: []*InputCounter{{Name: "poison", Value: "3"}},
+	})
+
+	card := bs.Field[0]
+	assert.Equal(t, []*Counter{{Name: "+1/+1", Value: "2", AssignedBy: &alice}}, card.Counters)
+	assert.Equal(t, []*Label{{Name: "note", Value: "stolen", AssignedBy: "bob"}}, card.Labels)
+	assert.Equal(t, []*Counter{{Name: "poison", Value: "3"}}, bs.Counters)
+}
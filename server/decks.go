@@ -26,7 +26,6 @@ func (s *graphQLServer) CreateDeck(ctx context.Context, deck *InputDeck) (*Board
 	}
 	// NB: We need to verify the length of the returned deck.
 	// If there isn't 99 cards, we need to figure out why or throw an error.
-	// NB: Handle split cards with Split property in DB?
 
 	// parse card names from input deck (if not already in separated strings)
 	// fetch cards from database
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+)
+
+// The designations a player or the game can have.
+const (
+	monarch       = "monarch"
+	initiative    = "initiative"
+	citysBlessing = "city's blessing"
+	dayNight      = "day/night"
+)
+
+// GiveDesignation gives a player, the one asking if player isn't given, the
+// monarch, the initiative or the city's blessing. Only one player can be the
+// monarch or have the initiative, so they're taken from whoever had them.
+// The city's blessing is kept for the rest of the game.
+func (s *graphQLServer) GiveDesignation(ctx context.Context, gameID string, username string, name string, player *string) (*Game, error) {
+	var events []*GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		p := username
+		if player != nil {
+			p = *player
+		}
+		if seat := findSeat(game, p); seat == nil || seat.Eliminated {
+			return ErrGame.New("%s isn't playing in the game", p)
+		}
+
+		switch name {
+		case monarch, initiative:
+			events = giveExclusive(game, name, p)
+		case citysBlessing:
+			if holds(game, citysBlessing, p) {
+				return ErrGame.New("%s already has the city's blessing", p)
+			}
+			game.Designations = append(game.Designations, &Designation{Name: name, Player: &p})
+			events = append(events, newEvent(gameID, "designation", p, "%s gets the city's blessing", p))
+		default:
+			return ErrGame.New("%s isn't a designation players can have", name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(events...)
+}
+
+// RemoveDesignation removes a designation from the game. If player is given,
+// only their designation is removed.
+func (s *graphQLServer) RemoveDesignation(ctx context.Context, gameID string, username string, name string, player *string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		kept := game.Designations[:0]
+		for _, d := range game.Designations {
+			if d.Name == name && (player == nil || (d.Player != nil && *d.Player == *player)) {
+				continue
+			}
+			kept = append(kept, d)
+		}
+		if len(kept) == len(game.Designations) {
+			return ErrGame.New("nobody has the %s", name)
+		}
+		game.Designations = kept
+		event = newEvent(gameID, "designation", username, "%s removes the %s", username, name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// SetDayNight makes it day or night. From then on it changes at the start
+// of each turn: day becomes night if the previous turn's active player cast
+// no spells, and night becomes day if they cast two or more.
+func (s *graphQLServer) SetDayNight(ctx context.Context, gameID string, username string, value string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		if value != "day" && value != "night" {
+			return ErrGame.New("it can only be day or night, not %s", value)
+		}
+		setDayNight(game, value)
+		event = newEvent(gameID, "designation", username, "it becomes %s", value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// giveExclusive gives a designation only one player can have to the player,
+// and returns the events it triggers.
+func giveExclusive(game *Game, name string, player string) []*GameEvent {
+	kept := game.Designations[:0]
+	for _, d := range game.Designations {
+		if d.Name != name {
+			kept = append(kept, d)
+		}
+	}
+	game.Designations = append(kept, &Designation{Name: name, Player: &player})
+
+	if name == monarch {
+		return []*GameEvent{newEvent(game.ID, "designation", player, "%s becomes the monarch", player)}
+	}
+	return []*GameEvent{
+		newEvent(game.ID, "designation", player, "%s takes the initiative", player),
+		newEvent(game.ID, "trigger", player, "%s ventures into the Undercity", player),
+	}
+}
+
+// holds returns true if the player has the designation.
+func holds(game *Game, name string, player string) bool {
+	for _, d := range game.Designations {
+		if d.Name == name && d.Player != nil && *d.Player == player {
+			return true
+		}
+	}
+	return false
+}
+
+// dayOrNight returns whether it's day or night, or "" if it's neither.
+func dayOrNight(game *Game) string {
+	for _, d := range game.Designations {
+		if d.Name == dayNight && d.Value != nil {
+			return *d.Value
+		}
+	}
+	return ""
+}
+
+func setDayNight(game *Game, value string) {
+	for _, d := range game.Designations {
+		if d.Name == dayNight {
+			d.Value = &value
+			return
+		}
+	}
+	game.Designations = append(game.Designations, &Designation{Name: dayNight, Value: &value})
+}
+
+// turnTriggers applies the designations' turn-based triggers between the
+// end of one player's turn and the start of the next player's, and returns
+// events noting them.
+func turnTriggers(game *Game, ending string, next string) []*GameEvent {
+	var events []*GameEvent
+	if holds(game, monarch, ending) {
+		events = append(events, newEvent(game.ID, "trigger", ending, "%s draws a card for being the monarch", ending))
+	}
+
+	spells := 0
+	if game.SpellsCast != nil {
+		spells = *game.SpellsCast
+	}
+	switch {
+	case dayOrNight(game) == "day" && spells == 0:
+		setDayNight(game, "night")
+		events = append(events, newEvent(game.ID, "trigger", "", "it becomes night"))
+	case dayOrNight(game) == "night" && spells >= 2:
+		setDayNight(game, "day")
+		events = append(events, newEvent(game.ID, "trigger", "", "it becomes day"))
+	}
+	game.SpellsCast = nil
+
+	if holds(game, initiative, next) {
+		events = append(events, newEvent(game.ID, "trigger", next, "%s ventures into the Undercity for having the initiative", next))
+	}
+	return events
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesignations(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	vault := testCard(t, s, "Lim-Dûl's Vault")
+	vault2 := testCard(t, s, "Lim-Dûl's Vault")
+	putBoard(t, s, "alice", &BoardState{Life: 40, Field: []*Card{angel}, Hand: []*Card{vault, vault2}})
+	putBoard(t, s, "bob", &BoardState{Life: 40})
+	putBoard(t, s, "carol", &BoardState{Life: 40})
+	s.Directory["game"].Turn = &Turn{Player: "alice", Phase: "main phase 1", Number: 1}
+
+	holder := func(name string) string {
+		for _, d := range s.Directory["game"].Designations {
+			if d.Name == name && d.Player != nil {
+				return *d.Player
+			}
+		}
+		return ""
+	}
+	texts := func(t *testing.T) []string {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		out := []string{}
+		for _, e := range events {
+			out = append(out, e.Text)
+		}
+		return out
+	}
+
+	t.Run("test transfers the monarch and the initiative", func(t *testing.T) {
+		_, err := s.GiveDesignation(ctx, "game", "alice", monarch, nil)
+		assert.NoError(t, err)
+		_, err = s.GiveDesignation(ctx, "game", "alice", monarch, str("bob"))
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", holder(monarch))
+		_, err = s.GiveDesignation(ctx, "game", "carol", initiative, nil)
+		assert.NoError(t, err)
+
+		game, err := s.GiveDesignation(ctx, "game", "alice", citysBlessing, nil)
+		assert.NoError(t, err)
+		assert.Len(t, game.Designations, 3)
+		_, err = s.GiveDesignation(ctx, "game", "alice", citysBlessing, nil)
+		assert.True(t, ErrGame.Has(err))
+		_, err = s.GiveDesignation(ctx, "game", "alice", "mayor", nil)
+		assert.True(t, ErrGame.Has(err))
+
+		assert.Contains(t, texts(t), "carol ventures into the Undercity")
+	})
+
+	t.Run("test combat damage takes the monarch", func(t *testing.T) {
+		_, err := s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{{CardID: *angel.InstanceID, Defender: "bob"}})
+		assert.NoError(t, err)
+		_, err = s.CombatDamage(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", holder(monarch))
+		assert.Equal(t, "carol", holder(initiative))
+	})
+
+	t.Run("test day and night", func(t *testing.T) {
+		_, err := s.SetDayNight(ctx, "game", "alice", "dusk")
+		assert.True(t, ErrGame.Has(err))
+		_, err = s.SetDayNight(ctx, "game", "alice", "day")
+		assert.NoError(t, err)
+
+		game, err := s.PassTurn(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, "night", dayOrNight(game), "alice cast no spells")
+		log := texts(t)
+		assert.Contains(t, log, "alice draws a card for being the monarch")
+		assert.Contains(t, log, "it becomes night")
+
+		_, err = s.PassTurn(ctx, "game", "bob")
+		assert.NoError(t, err)
+		assert.Contains(t, texts(t), "carol ventures into the Undercity for having the initiative")
+
+		_, err = s.PassTurn(ctx, "game", "carol")
+		assert.NoError(t, err)
+		for _, v := range []*Card{vault, vault2} {
+			_, err = s.CastSpell(ctx, "game", "alice", *v.InstanceID, nil, nil, nil)
+			assert.NoError(t, err)
+			for _, p := range []string{"alice", "bob", "carol"} {
+				_, err = s.PassPriority(ctx, "game", p)
+				assert.NoError(t, err)
+			}
+		}
+		assert.Equal(t, 2, *s.Directory["game"].SpellsCast)
+		game, err = s.PassTurn(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, "day", dayOrNight(game))
+		assert.Nil(t, game.SpellsCast)
+	})
+
+	t.Run("test eliminated players' designations", func(t *testing.T) {
+		// it's bob's turn
+		_, err := s.EliminatePlayer(ctx, "game", "carol", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", holder(initiative))
+		game, err := s.EliminatePlayer(ctx, "game", "alice", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", holder(monarch))
+		assert.False(t, holds(game, citysBlessing, "alice"))
+	})
+
+	t.Run("test removes designations", func(t *testing.T) {
+		game, err := s.RemoveDesignation(ctx, "game", "bob", monarch, nil)
+		assert.NoError(t, err)
+		assert.False(t, holds(game, monarch, "bob"))
+		_, err = s.RemoveDesignation(ctx, "game", "bob", monarch, nil)
+		assert.True(t, ErrGame.Has(err))
+	})
+}
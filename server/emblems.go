@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+)
+
+// AddEmblem gives the player an emblem. value is the emblem's text. A
+// player can have more than one emblem with the same name.
+func (s *graphQLServer) AddEmblem(ctx context.Context, gameID string, username string, name string, value string) (*BoardState, error) {
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		if name == "" {
+			return ErrBoardState.New("an emblem needs a name")
+		}
+		bs.Emblems = append(bs.Emblems, &Emblem{
+			Name:   name,
+			Value:  value,
+			Player: &User{Username: username},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(newEvent(gameID, "emblem", username, "%s gets an emblem: %s", username, name))
+}
+
+// RemoveEmblem removes one of the player's emblems with the given name.
+func (s *graphQLServer) RemoveEmblem(ctx context.Context, gameID string, username string, name string) (*BoardState, error) {
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		for i, e := range bs.Emblems {
+			if e.Name == name {
+				bs.Emblems = append(bs.Emblems[:i], bs.Emblems[i+1:]...)
+				return nil
+			}
+		}
+		return ErrBoardState.New("%s has no emblem named %s", username, name)
+	})
+}
+
+// emblemsFromInput converts InputEmblems to Emblems. Emblems without a
+// player belong to username.
+func emblemsFromInput(username string, in []*InputEmblem) []*Emblem {
+	var out []*Emblem
+	for _, e := range in {
+		if e == nil {
+			continue
+		}
+		player := &User{Username: username}
+		if e.Player != nil {
+			player = &User{Username: e.Player.Username}
+			if e.Player.ID != nil {
+				player.ID = *e.Player.ID
+			}
+		}
+		out = append(out, &Emblem{Name: e.Name, Value: e.Value, Player: player})
+	}
+	return out
+}
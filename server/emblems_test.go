@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmblems(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	putBoard(t, s, "alice", &BoardState{Life: 40})
+
+	bs, err := s.AddEmblem(ctx, "game", "alice", "Elspeth, Knight-Errant", "Artifacts, creatures, enchantments, and lands you control have indestructible.")
+	assert.NoError(t, err)
+	_, err = s.AddEmblem(ctx, "game", "alice", "", "nameless")
+	assert.True(t, ErrBoardState.Has(err))
+	bs, err = s.AddEmblem(ctx, "game", "alice", "Elspeth, Knight-Errant", "again")
+	assert.NoError(t, err)
+	assert.Len(t, bs.Emblems, 2)
+	assert.Equal(t, "alice", bs.Emblems[0].Player.Username)
+
+	t.Run("test persists emblems", func(t *testing.T) {
+		saved, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, bs.Emblems, saved.Emblems)
+	})
+
+	t.Run("test keeps emblems through board updates", func(t *testing.T) {
+		updated := boardStateFromInput(InputBoardState{
+			User:   &InputUser{Username: "alice"},
+			GameID: "game",
+			Emblems: []*InputEmblem{
+				{Name: "Elspeth, Knight-Errant", Value: "again", Player: &InputUser{Username: "alice"}},
+				{Name: "Teferi", Value: "untap"},
+			},
+		})
+		assert.Equal(t, []*Emblem{
+			{Name: "Elspeth, Knight-Errant", Value: "again", Player: &User{Username: "alice"}},
+			{Name: "Teferi", Value: "untap", Player: &User{Username: "alice"}},
+		}, updated.Emblems)
+	})
+
+	t.Run("test removes one emblem", func(t *testing.T) {
+		bs, err := s.RemoveEmblem(ctx, "game", "alice", "Elspeth, Knight-Errant")
+		assert.NoError(t, err)
+		assert.Len(t, bs.Emblems, 1)
+		assert.Equal(t, "again", bs.Emblems[0].Value)
+
+		_, err = s.RemoveEmblem(ctx, "game", "alice", "Teferi")
+		assert.True(t, ErrBoardState.Has(err))
+	})
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// EventsKey returns the KV key of a game's event log. Events are also
+// published on a pub/sub channel of the same name.
+func EventsKey(gameID string) string {
+	return fmt.Sprintf("events:%s", gameID)
+}
+
+// newEvent returns a GameEvent of the given kind. player is the player it's
+// about, if any.
+func newEvent(gameID, kind, player, format string, args ...interface{}) *GameEvent {
+	e := &GameEvent{
+		ID:        ksuid.New().String(),
+		GameID:    gameID,
+		Kind:      kind,
+		Text:      fmt.Sprintf(format, args...),
+		CreatedAt: time.Now().UTC(),
+	}
+	if player != "" {
+		e.Player = &player
+	}
+	return e
+}
+
+// logEvents appends events to their game's event log and publishes them to
+// the game's gameEvents subscribers. The log expires along with the rest of
+// the game's state.
+func (s *graphQLServer) logEvents(events ...*GameEvent) error {
+	for _, e := range events {
+		p, err := json.Marshal(e)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		key := EventsKey(e.GameID)
+		if _, err := s.kv.LPush(persistence.Key(key), persistence.Value(p)); err != nil {
+			return errs.Wrap(err)
+		}
+		if _, err := s.kv.Expire(persistence.Key(key), 12*time.Hour); err != nil {
+			return errs.Wrap(err)
+		}
+		if _, err := s.kv.Publish(key, persistence.Value(p)); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Events returns a game's event log, oldest first.
+func (s *graphQLServer) Events(ctx context.Context, gameID string) ([]*GameEvent, error) {
+	res, err := s.kv.LRange(persistence.Key(EventsKey(gameID)), 0, -1)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	events := make([]*GameEvent, 0, len(res))
+	for i := len(res) - 1; i >= 0; i-- {
+		e := &GameEvent{}
+		if err := json.Unmarshal([]byte(res[i]), e); err != nil {
+			log.Printf("error unmarshaling game event: %s", err)
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// GameEvents subscribes to a game's events as they're logged.
+func (s *graphQLServer) GameEvents(ctx context.Context, gameID string) (<-chan *GameEvent, error) {
+	sub, err := s.kv.Subscribe(EventsKey(gameID))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	events := make(chan *GameEvent, 1)
+	go func() {
+		defer close(events)
+		defer func() { _ = sub.Close() }()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-sub.Messages():
+				if !ok {
+					return
+				}
+				e := &GameEvent{}
+				if err := json.Unmarshal([]byte(msg), e); err != nil {
+					log.Printf("error unmarshaling game event: %s", err)
+					continue
+				}
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
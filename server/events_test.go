@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvents(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := s.GameEvents(ctx, "game")
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.logEvents(
+		newEvent("game", "test", "alice", "first %d", 1),
+		newEvent("other", "test", "", "elsewhere"),
+		newEvent("game", "test", "", "second"),
+	))
+
+	events, err := s.Events(ctx, "game")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "first 1", events[0].Text)
+		assert.Equal(t, "alice", *events[0].Player)
+		assert.Equal(t, "second", events[1].Text)
+		assert.Nil(t, events[1].Player)
+	}
+
+	for _, want := range []string{"first 1", "second"} {
+		select {
+		case e := <-sub:
+			assert.Equal(t, want, e.Text)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-sub:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription wasn't closed")
+	}
+}
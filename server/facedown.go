@@ -0,0 +1,408 @@
+package server
+
+import (
+	"context"
+)
+
+// faceDownStat is the power and toughness of a face-down creature.
+const faceDownStat = 2
+
+// TurnFaceDown turns a permanent the player controls face down. Only its
+// controller can see what it is, and to everyone else it's a 2/2 creature
+// with no name. cardID is the card's instance ID, and the card gets a new
+// one once it's face down.
+func (s *graphQLServer) TurnFaceDown(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		card := findPermanent(updated, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not on %s's battlefield", cardID, username)
+		}
+		if isFaceDown(card) {
+			return ErrBoardState.New("card %s is already face down", cardID)
+		}
+		faceDown := true
+		card.FaceDown = &faceDown
+		card.Viewers = nil
+		hideIdentity(card, boards)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(newEvent(gameID, "face down", username, "%s turns a permanent face down", username))
+}
+
+// Manifest puts the top card of the player's library onto the battlefield
+// face down, with a new instance ID.
+func (s *graphQLServer) Manifest(ctx context.Context, gameID string, username string) (*BoardState, error) {
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		if len(bs.Library) == 0 {
+			return ErrBoardState.New("%s's library is empty", username)
+		}
+		card := bs.Library[0]
+		bs.Library = bs.Library[1:]
+		faceDown := true
+		card.FaceDown = &faceDown
+		card.Viewers = nil
+		hideIdentity(card, nil)
+		putPermanent(bs, card)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(newEvent(gameID, "face down", username, "%s manifests the top card of their library", username))
+}
+
+// TurnFaceUp turns a face-down card face up and names it in the game's
+// event log. The card is a permanent the player controls, or a card in
+// their exile they're allowed to look at. cardID is the card's instance ID.
+func (s *graphQLServer) TurnFaceUp(ctx context.Context, gameID string, username string, cardID string) (*BoardState, error) {
+	var name string
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		card := findPermanent(bs, cardID)
+		if card == nil {
+			card = findCard(bs.Exiled, cardID)
+		}
+		if card == nil || !canSee(card, username) {
+			return ErrBoardState.New("card %s is not one of %s's face-down cards", cardID, username)
+		}
+		if !isFaceDown(card) {
+			return ErrBoardState.New("card %s is already face up", cardID)
+		}
+		turnFaceUp(card)
+		name = card.Name
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(newEvent(gameID, "face up", username, "%s turns %s face up", username, name))
+}
+
+// ExileFaceDown exiles a card face down. The card can be in any of the
+// player's zones or be a permanent on any battlefield, and it's put into
+// its owner's exile. The player and viewers can look at it; nobody else,
+// not even its owner unless listed, can. cardID is the card's instance ID,
+// and the card gets a new one in exile.
+func (s *graphQLServer) ExileFaceDown(ctx context.Context, gameID string, username string, cardID string, viewers []string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		card := takeCard(ownedZones(updated), cardID)
+		if card == nil {
+			card = takePermanent(boards, cardID)
+		}
+		if card == nil {
+			return ErrBoardState.New("card %s is not in one of %s's zones or on the battlefield", cardID, username)
+		}
+		leaveBattlefield(card)
+		card.Controller = nil
+		faceDown := true
+		card.FaceDown = &faceDown
+		card.Viewers = []string{username}
+		for _, v := range viewers {
+			addViewer(card, v)
+		}
+		hideIdentity(card, boards)
+		// state-based actions move it to its owner's exile
+		updated.Exiled = append(updated.Exiled, card)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(newEvent(gameID, "exile", username, "%s exiles a card face down", username))
+}
+
+// AllowViewer lets another player look at a face-down card the player can
+// see. cardID is the card's instance ID, and the card can be anywhere in the
+// game.
+func (s *graphQLServer) AllowViewer(ctx context.Context, gameID string, username string, cardID string, viewer string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		var card *Card
+		for _, bs := range boards {
+			for _, zone := range zones(bs) {
+				if c := findCard(zone, cardID); c != nil {
+					card = c
+				}
+			}
+		}
+		if card == nil || !isFaceDown(card) || !canSee(card, username) {
+			return ErrBoardState.New("card %s is not a face-down card %s can see", cardID, username)
+		}
+		if len(card.Viewers) == 0 {
+			// keep it visible to whoever could see it before
+			card.Viewers = []string{username}
+		}
+		addViewer(card, viewer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(newEvent(gameID, "look", username, "%s lets %s look at a face-down card", username, viewer))
+}
+
+// hideIdentity gives a card that's turning face down a new instance ID, so
+// a player who saw it face up can't tell which face-down card it is. Cards
+// on the boards that refer to it by instance ID, like Auras attached to it
+// or creatures blocking it, are changed to the new one. boards can be nil
+// if nothing can refer to the card, like when it comes from a library.
+func hideIdentity(c *Card, boards map[string]*BoardState) {
+	old := instanceID(c)
+	c.InstanceID = newInstanceID()
+	if old == "" {
+		return
+	}
+	for _, bs := range boards {
+		for _, zone := range zones(bs) {
+			for _, other := range zone {
+				if other == nil {
+					continue
+				}
+				if other.AttachedTo != nil && *other.AttachedTo == old {
+					other.AttachedTo = c.InstanceID
+				}
+				if other.Attacking != nil && *other.Attacking == old {
+					other.Attacking = c.InstanceID
+				}
+				for i, id := range other.Blocking {
+					if id == old {
+						other.Blocking[i] = *c.InstanceID
+					}
+				}
+			}
+		}
+	}
+}
+
+func isFaceDown(c *Card) bool {
+	return c.FaceDown != nil && *c.FaceDown
+}
+
+func turnFaceUp(c *Card) {
+	c.FaceDown = nil
+	c.Viewers = nil
+}
+
+func addViewer(c *Card, viewer string) {
+	for _, v := range c.Viewers {
+		if v == viewer {
+			return
+		}
+	}
+	c.Viewers = append(c.Viewers, viewer)
+}
+
+// canSee returns true if the viewer is allowed to know what a card is. Face
+// up cards can be seen by everyone. A face-down card can be seen by its
+// Viewers, or, if it has none, by its controller, or its owner if it isn't
+// on the battlefield.
+func canSee(c *Card, viewer string) bool {
+	if !isFaceDown(c) {
+		return true
+	}
+	if len(c.Viewers) > 0 {
+		return isViewer(c, viewer)
+	}
+	if c.Controller != nil {
+		return *c.Controller == viewer
+	}
+	return owner(c) != "" && owner(c) == viewer
+}
+
+// canSeeIn returns true if the viewer is allowed to know what a card in one
+// of a board's zones is. On top of canSee, nobody can see the cards in a
+// library and only the board's player can see the cards in their hand,
+// unless they've been allowed to look at them, as Viewers.
+func canSeeIn(bs *BoardState, zone *[]*Card, c *Card, viewer string) bool {
+	switch zone {
+	case &bs.Library:
+		if !isViewer(c, viewer) {
+			return false
+		}
+	case &bs.Hand:
+		if !isViewer(c, viewer) && (bs.User == nil || bs.User.Username != viewer) {
+			return false
+		}
+	}
+	return canSee(c, viewer)
+}
+
+func isViewer(c *Card, viewer string) bool {
+	for _, v := range c.Viewers {
+		if v == viewer {
+			return true
+		}
+	}
+	return false
+}
+
+// publicName returns how a card is named to every player, like in the
+// game's event log.
+func publicName(c *Card) string {
+	if isFaceDown(c) {
+		return "a face-down card"
+	}
+	return c.Name
+}
+
+// hiddenName is what a hidden card that isn't face down, like one in a
+// library, is called.
+const hiddenName = "a card"
+
+// hiddenCard returns what a card looks like to a player who can't see it:
+// only its state in the game, without anything that tells what the card
+// is.
+func hiddenCard(c *Card) *Card {
+	name := hiddenName
+	if isFaceDown(c) {
+		name = publicName(c)
+	}
+	return &Card{
+		Name:         name,
+		InstanceID:   c.InstanceID,
+		Tapped:       c.Tapped,
+		FaceDown:     c.FaceDown,
+		Viewers:      c.Viewers,
+		Counters:     c.Counters,
+		Labels:       c.Labels,
+		AttachedTo:   c.AttachedTo,
+		Owner:        c.Owner,
+		Controller:   c.Controller,
+		Attacking:    c.Attacking,
+		Blocking:     c.Blocking,
+		Damage:       c.Damage,
+		LethalDamage: c.LethalDamage,
+	}
+}
+
+// hiddenCreature returns what a face-down permanent or spell looks like to
+// a player who can't see it: a hidden card that's a 2/2 creature.
+func hiddenCreature(c *Card) *Card {
+	hidden := hiddenCard(c)
+	two, types := "2", "Creature"
+	hidden.Power, hidden.Toughness, hidden.Types = &two, &two, &types
+	return hidden
+}
+
+// visibleTo returns a board state as the viewer sees it, with the cards
+// they can't see, like face-down cards and the cards in libraries, replaced
+// by hidden cards. On the battlefield those are 2/2 creatures. The board is
+// returned as is if nothing on it is hidden, and is never changed.
+func visibleTo(bs *BoardState, viewer string) *BoardState {
+	if bs == nil {
+		return nil
+	}
+	out := *bs
+	hid := false
+	for _, zone := range append(ownedZones(&out), &out.Field, &out.Controlled) {
+		onField := zone == &out.Field || zone == &out.Controlled
+		var visible []*Card
+		for i, c := range *zone {
+			if c == nil || canSeeIn(&out, zone, c, viewer) {
+				continue
+			}
+			if visible == nil {
+				visible = append([]*Card(nil), *zone...)
+			}
+			if onField {
+				visible[i] = hiddenCreature(c)
+			} else {
+				visible[i] = hiddenCard(c)
+			}
+		}
+		if visible != nil {
+			*zone = visible
+			hid = true
+		}
+	}
+	if !hid {
+		return bs
+	}
+	return &out
+}
+
+// gameVisibleTo returns a game as the viewer sees it, with the face-down
+// spells on the stack they can't see replaced by hidden cards. Like
+// visibleTo, the game is returned as is if nothing is hidden, and is never
+// changed.
+func gameVisibleTo(game *Game, viewer string) *Game {
+	if game == nil {
+		return nil
+	}
+	var stack []*StackItem
+	for i, item := range game.Stack {
+		if item.Card == nil || canSee(item.Card, viewer) {
+			continue
+		}
+		if stack == nil {
+			stack = append([]*StackItem(nil), game.Stack...)
+		}
+		hidden := *item
+		hidden.Card = hiddenCreature(item.Card)
+		stack[i] = &hidden
+	}
+	if stack == nil {
+		return game
+	}
+	out := *game
+	out.Stack = stack
+	return &out
+}
+
+// restoreHidden puts back the hidden cards a player's client sent as it saw
+// them. The client only has hidden cards for the ones on the player's board
+// they can't see, so those are replaced by the cards from before the
+// update, matched by instance ID.
+func restoreHidden(before *BoardState, after *BoardState, username string) {
+	hidden := map[string]*Card{}
+	for _, zone := range append(ownedZones(before), &before.Field, &before.Controlled) {
+		for _, c := range *zone {
+			if c != nil && c.InstanceID != nil && !canSeeIn(before, zone, c, username) {
+				hidden[*c.InstanceID] = c
+			}
+		}
+	}
+	if len(hidden) == 0 {
+		return
+	}
+	for _, zone := range append(ownedZones(after), &after.Field, &after.Controlled) {
+		for i, c := range *zone {
+			if c == nil || c.InstanceID == nil {
+				continue
+			}
+			if card, ok := hidden[*c.InstanceID]; ok {
+				(*zone)[i] = card
+			}
+		}
+	}
+}
+
+// revealFaceDown turns face up the face-down cards that have left the
+// battlefield and exile, like a face-down creature that died.
+func revealFaceDown(boards map[string]*BoardState) {
+	for _, bs := range boards {
+		for _, zone := range []*[]*Card{&bs.Commander, &bs.Library, &bs.Graveyard, &bs.Hand, &bs.Revealed} {
+			for _, c := range *zone {
+				if c != nil && isFaceDown(c) {
+					turnFaceUp(c)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaceDown(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	teysa := testCard(t, s, "Teysa Karlov")
+	swamp := testCard(t, s, "Swamp")
+	vault := testCard(t, s, "Lim-Dûl's Vault")
+	putBoard(t, s, "alice", &BoardState{Life: 40, Field: []*Card{angel}, Library: []*Card{swamp}, Hand: []*Card{vault}})
+	putBoard(t, s, "bob", &BoardState{Life: 40, Field: []*Card{teysa}})
+
+	boardOf := func(t *testing.T, username string, viewer *string) *BoardState {
+		boards, err := s.Boardstates(ctx, "game", &username, viewer)
+		assert.NoError(t, err)
+		return boards[0]
+	}
+	named := func(zone []*Card, name string) *Card {
+		for _, c := range zone {
+			if c.Name == name {
+				return c
+			}
+		}
+		return nil
+	}
+
+	t.Run("test turns permanents face down", func(t *testing.T) {
+		earlier := *angel.InstanceID
+		bs, err := s.TurnFaceDown(ctx, "game", "alice", earlier)
+		assert.NoError(t, err)
+		card := named(bs.Field, "Serra Angel")
+		if !assert.NotNil(t, card, "its controller can see it") {
+			return
+		}
+		assert.NotEqual(t, earlier, *card.InstanceID, "it gets a new instance ID")
+		angel.InstanceID = card.InstanceID
+		for _, c := range boardOf(t, "alice", str("bob")).Field {
+			assert.NotEqual(t, earlier, *c.InstanceID, "bob can't tell which card it was")
+		}
+
+		hidden := findCard(boardOf(t, "alice", str("bob")).Field, *angel.InstanceID)
+		if assert.NotNil(t, hidden) {
+			assert.True(t, *hidden.FaceDown)
+			assert.Equal(t, "a face-down card", hidden.Name)
+			assert.Equal(t, "", hidden.ID)
+			assert.Nil(t, hidden.Text)
+			assert.Equal(t, "2", *hidden.Power)
+			assert.Equal(t, "Creature", *hidden.Types)
+		}
+		assert.Equal(t, "a face-down card", findCard(boardOf(t, "alice", nil).Field, *angel.InstanceID).Name)
+		assert.Equal(t, "Serra Angel", findCard(boardOf(t, "alice", str("alice")).Field, *angel.InstanceID).Name)
+
+		_, err = s.TurnFaceDown(ctx, "game", "alice", *angel.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.TurnFaceDown(ctx, "game", "alice", *teysa.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test face-down creatures are 2/2s in combat", func(t *testing.T) {
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		card := findCard(stored.Field, *angel.InstanceID)
+		assert.Equal(t, 2, power(card))
+		assert.Equal(t, 2, toughness(card))
+		assert.True(t, hasType(card, "Creature"))
+		assert.False(t, hasKeyword(card, "Flying"))
+
+		_, err = s.DeclareAttackers(ctx, "game", "alice", []*InputAttacker{{CardID: *angel.InstanceID, Defender: "bob"}})
+		assert.NoError(t, err)
+		res, err := s.CombatDamage(ctx, "game", "alice")
+		assert.NoError(t, err)
+		if assert.Len(t, res.Damage, 1) {
+			assert.Equal(t, "a face-down card", res.Damage[0].SourceName)
+		}
+		for _, bs := range res.Boards {
+			if card := findCard(bs.Field, *angel.InstanceID); card != nil {
+				assert.Equal(t, "Serra Angel", card.Name)
+			}
+		}
+		bob, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, 38, bob.Life)
+	})
+
+	t.Run("test copies of face-down permanents are face-down 2/2s", func(t *testing.T) {
+		bs, err := s.CopyPermanent(ctx, "game", "bob", *angel.InstanceID, nil)
+		assert.NoError(t, err)
+		stored, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		for _, board := range []*BoardState{bs, stored} {
+			if assert.Len(t, board.Field, 2) {
+				c := board.Field[1]
+				assert.True(t, isFaceDown(c))
+				assert.Equal(t, "a face-down card", c.Name)
+				assert.Equal(t, "", c.ID)
+				assert.Nil(t, c.Text)
+				assert.Equal(t, "2", *c.Power)
+				assert.Equal(t, "2", *c.Toughness)
+			}
+			for _, c := range board.Field {
+				assert.NotEqual(t, "Serra Angel", c.Name)
+			}
+		}
+	})
+
+	t.Run("test turns permanents face up", func(t *testing.T) {
+		_, err := s.TurnFaceUp(ctx, "game", "bob", *angel.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+
+		bs, err := s.TurnFaceUp(ctx, "game", "alice", *angel.InstanceID)
+		assert.NoError(t, err)
+		card := findCard(bs.Field, *angel.InstanceID)
+		assert.Nil(t, card.FaceDown)
+		assert.Equal(t, "Serra Angel", findCard(boardOf(t, "alice", str("bob")).Field, *angel.InstanceID).Name)
+
+		_, err = s.TurnFaceUp(ctx, "game", "alice", *angel.InstanceID)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test manifests the top of the library", func(t *testing.T) {
+		bs, err := s.Manifest(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Empty(t, bs.Library)
+		assert.Nil(t, findCard(bs.Field, *swamp.InstanceID), "it gets a new instance ID")
+		card := named(bs.Field, "Swamp")
+		if assert.NotNil(t, card) {
+			assert.True(t, *card.FaceDown)
+		}
+		_, err = s.Manifest(ctx, "game", "alice")
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test exiles face down with viewers", func(t *testing.T) {
+		bs, err := s.ExileFaceDown(ctx, "game", "alice", *teysa.InstanceID, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, bs.Exiled, "it goes to its owner's exile")
+
+		stored, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		assert.Nil(t, findCard(stored.Exiled, *teysa.InstanceID), "it gets a new instance ID")
+		exiled := named(stored.Exiled, "Teysa Karlov")
+		if !assert.NotNil(t, exiled) {
+			return
+		}
+		assert.Equal(t, []string{"alice"}, exiled.Viewers)
+		assert.Nil(t, exiled.Controller)
+		teysa.InstanceID = exiled.InstanceID
+		assert.Equal(t, "a face-down card", findCard(boardOf(t, "bob", str("bob")).Exiled, *teysa.InstanceID).Name)
+		assert.Equal(t, "Teysa Karlov", findCard(boardOf(t, "bob", str("alice")).Exiled, *teysa.InstanceID).Name)
+
+		_, err = s.AllowViewer(ctx, "game", "bob", *teysa.InstanceID, "bob")
+		assert.True(t, ErrBoardState.Has(err), "bob can't see it")
+		bs, err = s.AllowViewer(ctx, "game", "alice", *teysa.InstanceID, "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, "Teysa Karlov", findCard(boardOf(t, "bob", str("bob")).Exiled, *teysa.InstanceID).Name)
+
+		bs, err = s.ExileFaceDown(ctx, "game", "alice", *vault.InstanceID, []string{"bob"})
+		assert.NoError(t, err)
+		foretold := named(bs.Exiled, "Lim-Dûl's Vault")
+		if assert.NotNil(t, foretold) {
+			assert.Equal(t, []string{"alice", "bob"}, foretold.Viewers)
+			vault.InstanceID = foretold.InstanceID
+		}
+	})
+
+	t.Run("test keeps hidden cards the client sends back", func(t *testing.T) {
+		_, err := s.ExileFaceDown(ctx, "game", "bob", *vault.InstanceID, nil)
+		assert.True(t, ErrBoardState.Has(err))
+
+		bs, err := s.ExileFaceDown(ctx, "game", "bob", *angel.InstanceID, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, findCard(bs.Exiled, *angel.InstanceID), "it goes to its owner's exile")
+		before, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		angel.InstanceID = named(before.Exiled, "Serra Angel").InstanceID
+		hidden := findCard(boardOf(t, "alice", str("alice")).Exiled, *angel.InstanceID)
+		_, err = s.UpdateBoardState(ctx, InputBoardState{
+			User:   &InputUser{Username: "alice"},
+			GameID: "game",
+			Life:   40,
+			Exiled: []*InputCard{{Name: hidden.Name, InstanceID: hidden.InstanceID, FaceDown: hidden.FaceDown}},
+		})
+		assert.NoError(t, err)
+
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		card := findCard(stored.Exiled, *angel.InstanceID)
+		if assert.NotNil(t, card) {
+			assert.Equal(t, "Serra Angel", card.Name)
+			assert.Equal(t, []string{"bob"}, card.Viewers)
+		}
+	})
+
+	t.Run("test reveals face-down cards that leave the battlefield and exile", func(t *testing.T) {
+		bs, err := s.updateBoardState("game", "alice", func(bs *BoardState) error {
+			bs.Graveyard = append(bs.Graveyard, bs.Exiled...)
+			bs.Exiled = nil
+			return nil
+		})
+		assert.NoError(t, err)
+		card := findCard(bs.Graveyard, *angel.InstanceID)
+		if assert.NotNil(t, card) {
+			assert.Nil(t, card.FaceDown)
+			assert.Nil(t, card.Viewers)
+		}
+	})
+
+	t.Run("test never names hidden cards in the log", func(t *testing.T) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		for _, e := range events {
+			assert.NotContains(t, e.Text, "Teysa", e.Text)
+			assert.NotContains(t, e.Text, "Swamp", e.Text)
+			assert.NotContains(t, e.Text, "Vault", e.Text)
+		}
+		assert.Equal(t, "alice turns Serra Angel face up", events[3].Text)
+	})
+}
+
+func TestHideIdentity(t *testing.T) {
+	jace := &Card{Name: "Jace Beleren", InstanceID: str("jace")}
+	aura := &Card{Name: "Pacifism", InstanceID: str("aura"), AttachedTo: str("jace")}
+	blocker := &Card{Name: "Wall", InstanceID: str("wall"), Blocking: []string{"angel", "jace"}}
+	attacker := &Card{Name: "Serra Angel", InstanceID: str("angel"), Attacking: str("jace")}
+	boards := map[string]*BoardState{
+		"alice": {Field: []*Card{jace, blocker}},
+		"bob":   {Field: []*Card{aura, attacker}},
+	}
+
+	hideIdentity(jace, boards)
+	assert.NotEqual(t, "jace", *jace.InstanceID)
+	assert.Equal(t, *jace.InstanceID, *aura.AttachedTo)
+	assert.Equal(t, *jace.InstanceID, *attacker.Attacking)
+	assert.Equal(t, []string{"angel", *jace.InstanceID}, blocker.Blocking)
+
+	manifested := &Card{Name: "Swamp", InstanceID: str("swamp")}
+	hideIdentity(manifested, nil)
+	assert.NotEqual(t, "swamp", *manifested.InstanceID)
+}
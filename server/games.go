@@ -12,8 +12,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/imdario/mergo"
 	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
+	"github.com/dylanlott/edh-go/persistence"
 )
 
 // IPersistence defines the persistence interface for the server.
@@ -25,12 +27,18 @@ type IPersistence interface {
 
 var _ IPersistence = (&graphQLServer{})
 
-// Games returns a list of Games.
-func (s *graphQLServer) Games(ctx context.Context, gameID *string) ([]*Game, error) {
+// Games returns a list of Games. Face-down spells on the stack are hidden
+// unless viewer is allowed to see them.
+func (s *graphQLServer) Games(ctx context.Context, gameID *string, viewer *string) ([]*Game, error) {
+	v := ""
+	if viewer != nil {
+		v = *viewer
+	}
+
 	if gameID == nil {
 		games := []*Game{}
 		for _, game := range s.Directory {
-			games = append(games, game)
+			games = append(games, gameVisibleTo(game, v))
 		}
 
 		return games, nil
@@ -41,11 +49,17 @@ func (s *graphQLServer) Games(ctx context.Context, gameID *string) ([]*Game, err
 		return nil, errs.New("game [%+v] does not exist", gameID)
 	}
 
-	return []*Game{game}, nil
+	return []*Game{gameVisibleTo(game, v)}, nil
 }
 
-// Boardstates queries Redis for different boardstates per player or game
-func (s *graphQLServer) Boardstates(ctx context.Context, gameID string, username *string) ([]*BoardState, error) {
+// Boardstates queries Redis for different boardstates per player or game.
+// Face-down cards are hidden unless viewer is allowed to see them.
+func (s *graphQLServer) Boardstates(ctx context.Context, gameID string, username *string, viewer *string) ([]*BoardState, error) {
+	v := ""
+	if viewer != nil {
+		v = *viewer
+	}
+
 	game, ok := s.Directory[gameID]
 	if game == nil {
 		return nil, errs.New("game does not exist")
@@ -68,7 +82,7 @@ func (s *graphQLServer) Boardstates(ctx context.Context, gameID string, username
 			if err != nil {
 				log.Printf("error fetching user boardstate from redis: %s", err)
 			}
-			boardstates = append(boardstates, board)
+			boardstates = append(boardstates, visibleTo(board, v))
 		}
 		return boardstates, nil
 	} else {
@@ -82,7 +96,7 @@ func (s *graphQLServer) Boardstates(ctx context.Context, gameID string, username
 					log.Printf("error fetching user boardstate from redis: %s", err)
 				}
 
-				boardstates = append(boardstates, board)
+				boardstates = append(boardstates, visibleTo(board, v))
 			}
 		}
 
@@ -154,6 +168,90 @@ func (s *graphQLServer) GameUpdated(ctx context.Context, game InputGame) (<-chan
 	return games, nil
 }
 
+// ErrGame is returned when a change to a game isn't allowed.
+var ErrGame = errs.Class("game")
+
+// findGame returns the game with the given ID from the directory.
+func (s *graphQLServer) findGame(gameID string) (*Game, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	game, ok := s.Directory[gameID]
+	if !ok {
+		return nil, ErrGame.New("game %s does not exist", gameID)
+	}
+	return game, nil
+}
+
+// updateGameState applies fn to a copy of a game, along with the board
+// states of its players, as updateBoardStates does. If fn succeeds the copy
+// replaces the game in the directory, and is saved and published to the
+// game's gameUpdated subscribers. The game is returned as the player sees
+// it.
+func (s *graphQLServer) updateGameState(gameID, username string, fn func(game *Game, boards map[string]*BoardState) error) (*Game, error) {
+	var updated *Game
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		game, err := s.findGame(gameID)
+		if err != nil {
+			return err
+		}
+
+		g := *game
+		g.Stack = append([]*StackItem(nil), game.Stack...)
+		g.Passed = append([]string(nil), game.Passed...)
+		g.ExtraTurns = append([]string(nil), game.ExtraTurns...)
+		g.Designations = nil
+		for _, d := range game.Designations {
+			c := *d
+			g.Designations = append(g.Designations, &c)
+		}
+		g.Seats = nil
+		for _, seat := range game.Seats {
+			c := *seat
+			g.Seats = append(g.Seats, &c)
+		}
+		if game.Turn != nil {
+			turn := *game.Turn
+			g.Turn = &turn
+		}
+		if err := fn(&g, boards); err != nil {
+			return err
+		}
+
+		s.mutex.Lock()
+		s.Directory[gameID] = &g
+		s.mutex.Unlock()
+		updated = &g
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Set(gameID, updated); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	s.publishGame(updated)
+	return gameVisibleTo(updated, username), nil
+}
+
+// publishGame sends a game to its gameUpdated subscription, if it has one.
+// It doesn't wait on slow subscribers. Every player shares the
+// subscription, so face-down spells on the stack are hidden from all of
+// them.
+func (s *graphQLServer) publishGame(game *Game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ch, ok := s.gameChannels[game.ID]
+	if !ok {
+		return
+	}
+	select {
+	case ch <- gameVisibleTo(game, ""):
+	default:
+		log.Printf("dropped game update for %s", game.ID)
+	}
+}
+
 // BoardUpdate returns a channel that emits all the Boardstate's over it and then
 // listens for ctx.Done and then cleans up after itself.
 func (s *graphQLServer) BoardUpdate(ctx context.Context, bs InputBoardState) (<-chan *Game, error) {
@@ -184,8 +282,6 @@ func (s *graphQLServer) BoardUpdate(ctx context.Context, bs InputBoardState) (<-
 // UpdateGame is what's used to change the name of the game, format, insert
 // or remove players, or change other meta informatin about a game.
 // NB: Game _can_ touch boardstate right now, and it probably shouldn't.
-// NB: We eventually need a stronger support for combining two structs of different types
-// for GraphQL. Something like https://play.golang.org/p/UBCq0waIEe should eventually be used.
 func (s *graphQLServer) UpdateGame(ctx context.Context, new InputGame) (*Game, error) {
 	log.Printf("UpdateGame called with %+v\n", new)
 	// check existence of game, fail if not found
@@ -194,24 +290,26 @@ func (s *graphQLServer) UpdateGame(ctx context.Context, new InputGame) (*Game, e
 		return nil, errs.New("Game with ID %s does not exist", new.ID)
 	}
 
-	// update old game with new game data
-	if err := mergo.Merge(&new, old); err != nil {
-		return nil, errs.New("Failed to merge old game with new game: %s", err)
+	// only the handle and players come from the input, and the seats follow
+	// the players; the turn, stack and priority are only changed by their
+	// own mutations
+	g := *old
+	game := &g
+	if new.Handle != nil {
+		game.Handle = new.Handle
 	}
-	fmt.Printf("new game: %+v\n", &new)
-
-	// cast new game into Game for GraphQL
-	game := &Game{}
-	if err := mergo.Merge(game, new); err != nil {
-		return nil, errs.New("Failed to merge new game: %s", err)
+	if new.PlayerIDs != nil {
+		game.PlayerIDs = getPlayerIDs(new.PlayerIDs)
+		reseatPlayers(game)
 	}
 
-	fmt.Printf("fully updated game: %+v\n", game)
-
 	s.mutex.Lock()
 	s.Directory[new.ID] = game
-	s.gameChannels[new.ID] <- game
 	s.mutex.Unlock()
+	if err := s.Set(new.ID, game); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	s.publishGame(game)
 
 	return game, nil
 }
@@ -242,6 +340,7 @@ func (s *graphQLServer) CreateGame(ctx context.Context, inputGame InputCreateGam
 		},
 	}
 
+	var events []*GameEvent
 	for _, player := range inputGame.Players {
 		// TODO: Deck validation should happen here.
 		user := &User{
@@ -267,6 +366,10 @@ func (s *graphQLServer) CreateGame(ctx context.Context, inputGame InputCreateGam
 			decklist = string(*inputGame.Players[0].Decklist)
 		}
 		library, err := s.createLibraryFromDecklist(ctx, decklist)
+		if ErrUnresolvedCards.Has(err) {
+			// the player needs to fix their decklist
+			return nil, err
+		}
 		if err != nil {
 			// Fail gracefully and still populate basic cards
 			log.Printf("error creating library from decklist: %+v", err)
@@ -276,22 +379,29 @@ func (s *graphQLServer) CreateGame(ctx context.Context, inputGame InputCreateGam
 			bs.Library = library
 		}
 
-		commander, err := s.Card(ctx, player.Commander[0].Name, nil)
+		commander, err := s.commanderPrinting(player.Commander[0])
 		if err != nil {
 			log.Printf("error getting commander for deck: %+v", err)
 			// fail gracefully and use their card name so they can still play a game
 			inputCard := getCards(player.Commander)
 			bs.Commander = []*Card{inputCard[0]}
 		} else {
-			bs.Commander = []*Card{commander[0]}
+			bs.Commander = []*Card{cardFromModel(commander)}
 		}
 
-		shuff, err := Shuffle(bs.Library)
+		shuff, event, err := s.shuffle(g.ID, user.Username, bs.Library)
 		if err != nil {
 			log.Printf("error shuffling library: %s", err)
 			return nil, err
 		}
 		bs.Library = shuff
+		events = append(events, event)
+		isCommander := true
+		for _, c := range bs.Commander {
+			c.IsCommander = &isCommander
+		}
+		assignInstanceIDs(bs)
+		assignOwners(bs)
 		boardKey := BoardStateKey(g.ID, bs.User.Username)
 		err = s.Set(boardKey, bs)
 		if err != nil {
@@ -305,6 +415,12 @@ func (s *graphQLServer) CreateGame(ctx context.Context, inputGame InputCreateGam
 		s.mutex.Unlock()
 	}
 
+	event, err := seatPlayers(g)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, event)
+
 	// Set game in directory for access
 	s.mutex.Lock()
 	s.gameChannels[g.ID] = make(chan *Game, 1)
@@ -312,27 +428,39 @@ func (s *graphQLServer) CreateGame(ctx context.Context, inputGame InputCreateGam
 	s.mutex.Unlock()
 
 	// persist it to Redis
-	err := s.Set(g.ID, g)
+	err = s.Set(g.ID, g)
 	if err != nil {
 		log.Printf("error setting Game to redis: %+v\n", err)
 	}
+	if err := s.logEvents(events...); err != nil {
+		log.Printf("error logging game events: %s", err)
+	}
 
 	return g, nil
 }
 
+// UpdateBoardState replaces a player's board state with the one the client
+// sent. Face-down cards the player can't see are kept as they were. The
+// game's state-based actions are applied afterwards, so that, for example,
+// Auras on a permanent the player moved off the battlefield are put into
+// the graveyard, even on other players' boards.
 func (s *graphQLServer) UpdateBoardState(ctx context.Context, bs InputBoardState) (*BoardState, error) {
 	updated := boardStateFromInput(bs)
-	boardKey := BoardStateKey(bs.GameID, bs.User.Username)
-	err := s.Set(boardKey, updated)
+	username := bs.User.Username
+	err := s.updateBoardStates(bs.GameID, username, func(boards map[string]*BoardState) error {
+		if before, ok := boards[username]; ok {
+			restoreHidden(before, updated, username)
+		}
+		boards[username] = updated
+		return nil
+	})
 	if err != nil {
-		log.Printf("error updating boardstate in redis: %s", err)
+		log.Printf("error updating boardstate: %s", err)
+		return nil, err
 	}
 
-	s.mutex.Lock()
-	s.boardChannels[bs.User.Username] <- updated
-	s.mutex.Unlock()
 	pushBoardStateUpdate(ctx, s.observers, bs)
-	return updated, nil
+	return visibleTo(updated, username), nil
 }
 
 func pushBoardStateUpdate(ctx context.Context, observers []Observer, input InputBoardState) {
@@ -370,10 +498,11 @@ func gameFromInput(game InputGame) *Game {
 func getPlayerIDs(inputUsers []*InputUser) []*User {
 	var u []*User
 	for _, i := range inputUsers {
-		u = append(u, &User{
-			ID:       *i.ID,
-			Username: i.Username,
-		})
+		user := &User{Username: i.Username}
+		if i.ID != nil {
+			user.ID = *i.ID
+		}
+		u = append(u, user)
 	}
 
 	return u
@@ -388,191 +517,82 @@ func boardStateFromInput(bs InputBoardState) *BoardState {
 		GameID: bs.GameID,
 	}
 
-	for _, c := range bs.Commander {
-		out.Commander = append(out.Commander, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
-
-	for _, c := range bs.Library {
-		out.Library = append(out.Library, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
-
-	for _, c := range bs.Exiled {
-		out.Exiled = append(out.Exiled, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
-
-	for _, c := range bs.Field {
-		out.Field = append(out.Field, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
-
-	for _, c := range bs.Hand {
-		out.Hand = append(out.Hand, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
+	out.Commander = cardsFromInput(bs.Commander)
+	out.Library = cardsFromInput(bs.Library)
+	out.Graveyard = cardsFromInput(bs.Graveyard)
+	out.Exiled = cardsFromInput(bs.Exiled)
+	out.Field = cardsFromInput(bs.Field)
+	out.Hand = cardsFromInput(bs.Hand)
+	out.Controlled = cardsFromInput(bs.Controlled)
+	out.Revealed = cardsFromInput(bs.Revealed)
+	out.Counters = countersFromInput(bs.Counters)
+	out.Emblems = emblemsFromInput(bs.User.Username, bs.Emblems)
+	for _, cd := range bs.CommanderDamage {
+		damage := CommanderDamage(*cd)
+		out.CommanderDamage = append(out.CommanderDamage, &damage)
+	}
+	// cards keep their instance IDs and owners between updates, and any the
+	// client added get new ones
+	assignInstanceIDs(out)
+	assignOwners(out)
 
-	for _, c := range bs.Controlled {
-		out.Controlled = append(out.Controlled, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
-	}
+	return out
+}
 
-	for _, c := range bs.Revealed {
-		out.Revealed = append(out.Revealed, &Card{
-			Name:     c.Name,
-			ID:       *c.ID,
-			Quantity: c.Quantity,
-			Tapped:   c.Tapped,
-			Flipped:  c.Flipped,
-			// TODO: Handle counters and labels
-			// Counters:      c.Counters,
-			Colors:        c.Colors,
-			ColorIdentity: c.ColorIdentity,
-			Cmc:           c.Cmc,
-			ManaCost:      c.ManaCost,
-			UUID:          c.UUID,
-			Power:         c.Power,
-			Toughness:     c.Toughness,
-			Types:         c.Types,
-			Subtypes:      c.Subtypes,
-			Supertypes:    c.Supertypes,
-			IsTextless:    c.IsTextless,
-			Text:          c.Text,
-			Tcgid:         c.Tcgid,
-			ScryfallID:    c.ScryfallID,
-		})
+// cardsFromInput converts a zone of InputCards to Cards.
+func cardsFromInput(in []*InputCard) []*Card {
+	var out []*Card
+	for _, c := range in {
+		if c == nil {
+			continue
+		}
+		out = append(out, cardFromInput(c))
 	}
-
 	return out
 }
 
+// cardFromInput converts an InputCard to a Card.
+func cardFromInput(c *InputCard) *Card {
+	card := &Card{
+		Name:          c.Name,
+		InstanceID:    c.InstanceID,
+		Quantity:      c.Quantity,
+		Tapped:        c.Tapped,
+		Flipped:       c.Flipped,
+		FaceDown:      c.FaceDown,
+		Viewers:       c.Viewers,
+		Counters:      countersFromInput(c.Counters),
+		Labels:        labelsFromInput(c.Labels),
+		Colors:        c.Colors,
+		ColorIdentity: c.ColorIdentity,
+		Cmc:           c.Cmc,
+		ManaCost:      c.ManaCost,
+		UUID:          c.UUID,
+		Power:         c.Power,
+		Toughness:     c.Toughness,
+		Loyalty:       c.Loyalty,
+		Types:         c.Types,
+		Subtypes:      c.Subtypes,
+		Supertypes:    c.Supertypes,
+		IsTextless:    c.IsTextless,
+		Text:          c.Text,
+		Tcgid:         c.Tcgid,
+		ScryfallID:    c.ScryfallID,
+		Layout:        c.Layout,
+		FaceName:      c.FaceName,
+		Set:           c.Set,
+		Number:        c.Number,
+	}
+	if c.ID != nil {
+		card.ID = *c.ID
+	}
+	for _, f := range c.Faces {
+		face := CardFace(*f)
+		card.Faces = append(card.Faces, &face)
+	}
+	return card
+}
+
 func getCards(inputCards []*InputCard) []*Card {
 	cardList := []*Card{}
 
@@ -584,6 +604,7 @@ func getCards(inputCards []*InputCard) []*Card {
 		if card.ID != nil {
 			c.ID = *card.ID
 		}
+		c.InstanceID = card.InstanceID
 
 		cardList = append(cardList, c)
 	}
@@ -591,10 +612,65 @@ func getCards(inputCards []*InputCard) []*Card {
 	return cardList
 }
 
+// ErrUnresolvedCards is returned when a decklist has names that don't match
+// any card.
+var ErrUnresolvedCards = errs.Class("unresolved cards")
+
+// createLibraryFromDecklist builds a library from a CSV decklist with a
+// `quantity,name` line per card. A line can pick a printing with a third
+// column holding its MTGJSON uuid, or with set code and collector number
+// columns, e.g. `1,Swamp,LEA,278`; otherwise the default printing is used.
+// The whole decklist is looked up at once, and every line that can't be
+// matched to a card is reported in one ErrUnresolvedCards error.
 func (s *graphQLServer) createLibraryFromDecklist(ctx context.Context, decklist string) ([]*Card, error) {
+	entries, err := parseDecklist(decklist)
+	if err != nil {
+		return nil, err
+	}
+
+	hydrated, err := s.cards.Hydrate(entries)
+	if err != nil {
+		log.Printf("error looking up decklist: %+v\n", err)
+		return nil, errs.New("failed to look up decklist: %s", err)
+	}
+
+	library := []*Card{}
+	unresolved := []string{}
+	for _, h := range hydrated {
+		if !h.Resolution.Resolved() {
+			unresolved = append(unresolved, describeUnresolved(h.Resolution))
+			continue
+		}
+		if h.Err != nil {
+			unresolved = append(unresolved, fmt.Sprintf("%s has no printing %s", h.Resolution.Name, h.Printing))
+			continue
+		}
+		if h.Resolution.Match == cards.MatchFuzzy {
+			log.Printf("resolved %q to %q", h.Name, h.Resolution.Name)
+		}
+
+		for n := 0; n < h.Quantity; n++ {
+			card := cardFromModel(h.Card)
+			card.InstanceID = newInstanceID()
+			library = append(library, card)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return nil, ErrUnresolvedCards.New("%s", strings.Join(unresolved, "; "))
+	}
+
+	return library, nil
+}
+
+// parseDecklist reads the lines of a CSV decklist. See
+// createLibraryFromDecklist for the format.
+func parseDecklist(decklist string) ([]cards.DeckEntry, error) {
 	trimmed := strings.TrimSpace(decklist)
 	r := csv.NewReader(strings.NewReader(trimmed))
-	cards := []*Card{}
+	// lines can pick a printing with extra columns
+	r.FieldsPerRecord = -1
+	entries := []cards.DeckEntry{}
 
 	for {
 		record, err := r.Read()
@@ -602,58 +678,57 @@ func (s *graphQLServer) createLibraryFromDecklist(ctx context.Context, decklist
 			break
 		}
 		if err != nil {
-			// handle error path
 			log.Printf("error reading record: %+v", err)
 			return nil, errs.New("failed to parse CSV: %s", err)
 		}
+		if len(record) == 1 {
+			// a bare card name is one copy
+			record = []string{"1", record[0]}
+		}
 
-		quantity, err := strconv.ParseInt(record[0], 0, 64)
+		quantity, err := strconv.ParseInt(strings.TrimSpace(record[0]), 0, 64)
 		if err != nil {
-			// handle error
 			log.Printf("error parsing quantity: %+v\n", err)
 			// assume quantity = 1
 			quantity = 1
 		}
 
-		// NB: In the future, this should be optimized to be one query for all the cards
-		// instead of a query for each card in the deck.
-		name := record[1]
-		card, err := s.Card(ctx, name, nil)
-		if err != nil {
-			// handle lookup error
-			log.Printf("error looking up card: %+v\n", err)
-			cards = append(cards, &Card{
-				Name: name,
-			})
-			continue
-		}
+		entries = append(entries, cards.DeckEntry{
+			Quantity: int(quantity),
+			Name:     record[1],
+			Printing: cards.ParsePrinting(record[2:]...),
+		})
+	}
 
-		if card == nil {
-			fmt.Printf("failed to find card: %s", name)
-		}
-
-		// happy path
-		var num int64 = 1
-		for num <= quantity {
-			// Fail gracefully if we can't find the card
-			if len(card) == 0 {
-				fmt.Printf("failed to find card- adding dummy card instead")
-				cards = append(cards, &Card{
-					Name: name,
-				})
-				num++
-			} else {
-				// add the first card that's returned from the database
-				// NB: This is going to need to be handled eventually
-				cards = append(cards, card[0])
-				num++
-			}
-		}
+	return entries, nil
+}
 
-		continue
+// commanderPrinting looks up a player's commander, honoring the printing
+// they picked, if any.
+func (s *graphQLServer) commanderPrinting(c *InputCard) (cards.Card, error) {
+	if c == nil {
+		return cards.Card{}, errs.New("must provide a commander")
+	}
+	res, err := s.cards.Resolve(c.Name)
+	if err != nil {
+		return cards.Card{}, err
+	}
+	if !res.Resolved() {
+		return cards.Card{}, errs.New("%s", describeUnresolved(res))
 	}
+	if c.UUID == nil && c.Set == nil && c.Number == nil {
+		return res.Printings[0], nil
+	}
+	return s.printing(res.Name, c.UUID, c.Set, c.Number)
+}
 
-	return cards, nil
+// describeUnresolved formats a name that couldn't be found with its
+// suggestions, if any.
+func describeUnresolved(res cards.Resolution) string {
+	if len(res.Suggestions) == 0 {
+		return fmt.Sprintf("%q not found", res.Query)
+	}
+	return fmt.Sprintf("%q not found; did you mean %s?", res.Query, strings.Join(res.Suggestions, ", "))
 }
 
 // BoardStateKey formats a board state key for boardstate to user mapping.
@@ -661,7 +736,7 @@ func BoardStateKey(gameID, username string) string {
 	return fmt.Sprintf("%s:%s", gameID, username)
 }
 
-// Set will set a value into the Redis client and returns an error, if any
+// Set will set a value into the KV store and returns an error, if any
 func (s *graphQLServer) Set(key string, value interface{}) error {
 	exp, err := time.ParseDuration("12h")
 	if err != nil {
@@ -672,12 +747,20 @@ func (s *graphQLServer) Set(key string, value interface{}) error {
 		return err
 	}
 
-	return s.redisClient.Set(key, p, exp).Err()
+	if _, err := s.kv.Put(persistence.Key(key), persistence.Value(p)); err != nil {
+		return err
+	}
+	if exp > 0 {
+		if _, err := s.kv.Expire(persistence.Key(key), exp); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Get returns a value from Redis client to `dest` and returns an error, if any
+// Get returns a value from the KV store to `dest` and returns an error, if any
 func (s *graphQLServer) Get(key string, dest interface{}) error {
-	p, err := s.redisClient.Get(key).Result()
+	p, _, err := s.kv.Get(persistence.Key(key))
 	if err != nil {
 		return err
 	}
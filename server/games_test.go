@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLibraryFromDecklist(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	t.Run("test resolves names", func(t *testing.T) {
+		library, err := s.createLibraryFromDecklist(ctx, "2,swamp\n1,Lim-Dul's Vault\n1,Delver of Secrets\n1,Teysa Karlvo")
+		assert.NoError(t, err)
+		names := []string{}
+		for _, c := range library {
+			names = append(names, c.Name)
+		}
+		assert.Equal(t, []string{
+			"Swamp",
+			"Swamp",
+			"Lim-Dûl's Vault",
+			"Delver of Secrets // Insectile Aberration",
+			"Teysa Karlov",
+		}, names)
+		assert.NotNil(t, library[0].Types)
+
+		// copies share a card ID but not an instance ID
+		assert.Equal(t, library[0].ID, library[1].ID)
+		assert.NotEqual(t, *library[0].InstanceID, *library[1].InstanceID)
+	})
+
+	t.Run("test honors printings", func(t *testing.T) {
+		library, err := s.createLibraryFromDecklist(ctx, "1,Swamp\n1,Swamp,LEA,278\n1,Swamp,0a0b1c2d-0000-4000-8000-000000000004\n1,Lim-Dul's Vault,me2")
+		assert.NoError(t, err)
+		sets := []string{}
+		for _, c := range library {
+			sets = append(sets, *c.Set)
+		}
+		assert.Equal(t, []string{"RNA", "LEA", "LEA", "ME2"}, sets)
+		assert.Equal(t, "278", *library[1].Number)
+		assert.Equal(t, "illus-swamp-rna", *library[0].ScryfallID)
+
+		_, err = s.createLibraryFromDecklist(ctx, "1,Swamp,LEA,999")
+		assert.True(t, ErrUnresolvedCards.Has(err))
+		assert.EqualError(t, err, "unresolved cards: Swamp has no printing LEA #999")
+	})
+
+	t.Run("test unresolved names are reported", func(t *testing.T) {
+		_, err := s.createLibraryFromDecklist(ctx, "1,Swamp\n1,Sera Angle\n1,Black Lotus")
+		assert.True(t, ErrUnresolvedCards.Has(err))
+		assert.EqualError(t, err, `unresolved cards: "Sera Angle" not found; did you mean Serra Angel?; "Black Lotus" not found`)
+	})
+}
+
+func TestResolveCards(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+
+	res, err := s.ResolveCards(context.Background(), []string{"Teysa Karlov", "Sera Angle"})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "exact", res[0].Match)
+	assert.Equal(t, "Teysa Karlov", res[0].Card.Name)
+	assert.Equal(t, "none", res[1].Match)
+	assert.Nil(t, res[1].Card)
+	assert.Equal(t, []string{"Serra Angel"}, res[1].Suggestions)
+}
+
+func TestCard(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	found, err := s.Card(ctx, "Swamp", nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+	assert.Equal(t, "RNA", *found[0].Set)
+
+	found, err = s.Card(ctx, "Swamp", nil, str("lea"), str("278"))
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, "LEA", *found[0].Set)
+
+	found, err = s.Card(ctx, "Swamp", str("0a0b1c2d-0000-4000-8000-000000000004"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "278", *found[0].Number)
+
+	byID, err := s.Card(ctx, "Swamp", &found[0].ID, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, found, byID)
+
+	_, err = s.Card(ctx, "Swamp", nil, str("LEA"), str("1"))
+	assert.Error(t, err)
+}
+
+func TestCards(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+
+	found, err := s.Cards(context.Background(), []string{"Swamp", "Black Lotus", "Delver of Secrets", "Swamp"})
+	assert.NoError(t, err)
+	if assert.Len(t, found, 3) {
+		assert.Equal(t, "Swamp", found[0].Name)
+		assert.Equal(t, "RNA", *found[0].Set)
+		assert.Equal(t, "Delver of Secrets // Insectile Aberration", found[1].Name)
+		assert.Equal(t, "Swamp", found[2].Name)
+	}
+}
+
+func TestBoardStateFromInput(t *testing.T) {
+	bs := boardStateFromInput(InputBoardState{
+		User:      &InputUser{Username: "alice"},
+		GameID:    "game",
+		Graveyard: []*InputCard{{Name: "Swamp", ID: str("1"), InstanceID: str("a")}},
+		Hand:      []*InputCard{{Name: "Swamp", ID: str("1")}},
+	})
+
+	// instance IDs stay with a card as it changes zones
+	assert.Equal(t, "a", *bs.Graveyard[0].InstanceID)
+	assert.NotEqual(t, "a", *bs.Hand[0].InstanceID)
+	assert.NotEmpty(t, *bs.Hand[0].InstanceID)
+}
@@ -11,12 +11,13 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/handler"
+	"github.com/dylanlott/edh-go/cards"
 	"github.com/dylanlott/edh-go/persistence"
-	"github.com/go-redis/redis"
 	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 	"github.com/segmentio/ksuid"
 	"github.com/tinrab/retry"
+	"github.com/zeebo/errs"
 )
 
 type contextKey string
@@ -36,17 +37,23 @@ type Observer interface {
 type graphQLServer struct {
 	mutex sync.RWMutex
 
-	// TODO: Remove redisClient and switch over to our Persistence interface
-	redisClient *redis.Client
+	// boardMutex serializes board state updates.
+	boardMutex sync.Mutex
 
 	// Directory maps game ID's to a Game pointer
 	Directory map[string]*Game
 
 	// Persistence layers
-	kv     persistence.Persistence
+	kv     persistence.KV
 	db     persistence.Database
 	cardDB persistence.Database
 
+	// cards is the repository all card lookups go through.
+	cards *cards.Repository
+
+	// shuffler shuffles every library in the server's games.
+	shuffler Shuffler
+
 	// Channels per resource to achieve realtime
 	gameChannels    map[string]chan *Game
 	boardChannels   map[string]chan *BoardState
@@ -64,16 +71,14 @@ func NewGraphQLServer(
 	appDB persistence.Database,
 	cardDB persistence.Database,
 ) (*graphQLServer, error) {
-	// TODO: Remove this redis client and wire chat up to KV interface instead
-	client := redis.NewClient(&redis.Options{
-		// TODO: Make this take an environment variable instead
-		Addr: "localhost:6379",
-	})
+	if kv == nil {
+		return nil, errs.New("must provide a KV store")
+	}
 
 	retry.ForeverSleep(2*time.Second, func(_ int) error {
-		_, err := client.Ping().Result()
+		err := kv.Ping()
 		if err != nil {
-			log.Printf("error connecting to redis: %+v\n", err)
+			log.Printf("error connecting to kv store: %+v\n", err)
 		}
 		return err
 	})
@@ -81,9 +86,10 @@ func NewGraphQLServer(
 	return &graphQLServer{
 		mutex:           sync.RWMutex{},
 		cardDB:          cardDB,
+		cards:           cards.NewRepository(cardDB),
+		shuffler:        CryptoShuffler,
 		db:              appDB,
 		kv:              kv,
-		redisClient:     client,
 		messageChannels: map[string]chan *Message{},
 		userChannels:    map[string]chan string{},
 		gameChannels:    map[string]chan *Game{},
@@ -128,7 +134,7 @@ func (s *graphQLServer) PostMessage(ctx context.Context, user string, text strin
 	mj, _ := json.Marshal(m)
 
 	// Update messages to key off of `message:<game_id>` and `message:<room_id>`
-	if err := s.redisClient.LPush("messages", mj).Err(); err != nil {
+	if _, err := s.kv.LPush("messages", persistence.Value(mj)); err != nil {
 		log.Println(err)
 		return nil, err
 	}
@@ -142,12 +148,7 @@ func (s *graphQLServer) PostMessage(ctx context.Context, user string, text strin
 }
 
 func (s *graphQLServer) Messages(ctx context.Context) ([]*Message, error) {
-	cmd := s.redisClient.LRange("messages", 0, -1)
-	if cmd.Err() != nil {
-		log.Println(cmd.Err())
-		return nil, cmd.Err()
-	}
-	res, err := cmd.Result()
+	res, err := s.kv.LRange("messages", 0, -1)
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -165,17 +166,16 @@ func (s *graphQLServer) Messages(ctx context.Context) ([]*Message, error) {
 }
 
 func (s *graphQLServer) Users(ctx context.Context) ([]string, error) {
-	cmd := s.redisClient.SMembers("users")
-	if cmd.Err() != nil {
-		log.Println(cmd.Err())
-		return nil, cmd.Err()
-	}
-	res, err := cmd.Result()
+	res, err := s.kv.SMembers("users")
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
-	return res, nil
+	users := []string{}
+	for _, u := range res {
+		users = append(users, string(u))
+	}
+	return users, nil
 }
 
 func (s *graphQLServer) MessagePosted(ctx context.Context, user string) (<-chan *Message, error) {
@@ -233,7 +233,7 @@ func (s *graphQLServer) UserJoined(ctx context.Context, user string, gameID stri
 
 func (s *graphQLServer) createUser(user string) error {
 	// Upsert user
-	if err := s.redisClient.SAdd("users", user).Err(); err != nil {
+	if _, err := s.kv.SAdd("users", persistence.Value(user)); err != nil {
 		return err
 	}
 	// Notify new user joined
@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"strings"
+)
+
+// LookAtTop returns the top cards of the player's library without moving
+// them. Only the player sees which cards they are, and they stay visible to
+// them in their library until it's shuffled; the game's event log only says
+// how many they looked at.
+func (s *graphQLServer) LookAtTop(ctx context.Context, gameID string, username string, amount int) ([]*Card, error) {
+	var top []*Card
+	_, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		cards, err := topOfLibrary(bs, amount)
+		if err != nil {
+			return err
+		}
+		showTo(cards, username)
+		top = cards
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return top, s.logEvents(newEvent(gameID, "look", username, "%s looks at the top %d cards of their library", username, len(top)))
+}
+
+// RevealTop reveals the top cards of the player's library to the table by
+// naming them in the game's event log, and returns them. The cards stay
+// where they are, visible to every player until the library is shuffled.
+func (s *graphQLServer) RevealTop(ctx context.Context, gameID string, username string, amount int) ([]*Card, error) {
+	var top []*Card
+	_, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		cards, err := topOfLibrary(bs, amount)
+		if err != nil {
+			return err
+		}
+		showTo(cards, s.gamePlayers(gameID, username)...)
+		top = cards
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return top, s.logEvents(newEvent(gameID, "reveal", username, "%s reveals %s from the top of their library", username, cardNames(top)))
+}
+
+// Scry puts the top len(top)+len(bottom) cards of the player's library back
+// on the top or the bottom in the order they chose. top and bottom hold the
+// cards' instance IDs, from the top down, and every one of those cards must
+// be listed once. The cards are only named to the player, and stay visible
+// to them.
+func (s *graphQLServer) Scry(ctx context.Context, gameID string, username string, top []string, bottom []string) (*BoardState, error) {
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		kept, moved, rest, err := splitTop(bs.Library, top, bottom)
+		if err != nil {
+			return err
+		}
+		showTo(kept, username)
+		showTo(moved, username)
+		bs.Library = append(append(kept, rest...), moved...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(newEvent(gameID, "scry", username, "%s scries %d, keeping %d on top and putting %d on the bottom",
+		username, len(top)+len(bottom), len(top), len(bottom)))
+}
+
+// Surveil puts the top len(top)+len(graveyard) cards of the player's library
+// back on top, in the order listed, or into their graveyard. Like Scry, the
+// cards are listed by instance ID. The cards put into the graveyard are
+// named in the game's event log.
+func (s *graphQLServer) Surveil(ctx context.Context, gameID string, username string, top []string, graveyard []string) (*BoardState, error) {
+	var milled []*Card
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		kept, moved, rest, err := splitTop(bs.Library, top, graveyard)
+		if err != nil {
+			return err
+		}
+		milled = moved
+		showTo(kept, username)
+		bs.Library = append(kept, rest...)
+		bs.Graveyard = append(bs.Graveyard, moved...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	text := "keeps them on top"
+	if len(milled) > 0 {
+		text = "puts " + cardNames(milled) + " into their graveyard"
+	}
+	return updated, s.logEvents(newEvent(gameID, "surveil", username, "%s surveils %d and %s", username, len(top)+len(graveyard), text))
+}
+
+// Mill puts the top cards of the player's library into their graveyard.
+// Milling more cards than the library has mills all of them.
+func (s *graphQLServer) Mill(ctx context.Context, gameID string, username string, amount int) (*BoardState, error) {
+	var milled []*Card
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		if amount < 1 {
+			return ErrBoardState.New("must mill at least 1 card")
+		}
+		if amount > len(bs.Library) {
+			amount = len(bs.Library)
+		}
+		milled = append([]*Card(nil), bs.Library[:amount]...)
+		bs.Library = bs.Library[amount:]
+		bs.Graveyard = append(bs.Graveyard, milled...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(newEvent(gameID, "mill", username, "%s mills %s", username, cardNames(milled)))
+}
+
+// Tutor searches the player's library for a card, puts it into zone and
+// shuffles the library. zone is the name of a zone on the player's board,
+// like Hand or Field, and Library puts the card on top after the shuffle.
+// The card is named in the game's event log if it goes to a public zone or
+// reveal is true.
+func (s *graphQLServer) Tutor(ctx context.Context, gameID string, username string, cardID string, zone string, reveal *bool) (*BoardState, error) {
+	var (
+		card    *Card
+		shuffle *GameEvent
+	)
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		if _, ok := libraryDestinations[zone]; !ok {
+			return ErrBoardState.New("can't put a card into %s", zone)
+		}
+		card = takeCard([]*[]*Card{&bs.Library}, cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not in %s's library", cardID, username)
+		}
+		library, event, err := s.shuffle(gameID, username, bs.Library)
+		if err != nil {
+			return err
+		}
+		bs.Library, shuffle = library, event
+
+		switch zone {
+		case "Field":
+			putPermanent(bs, card)
+		case "Library":
+			showTo([]*Card{card}, username)
+			if reveal != nil && *reveal {
+				showTo([]*Card{card}, s.gamePlayers(gameID, username)...)
+			}
+			bs.Library = append([]*Card{card}, bs.Library...)
+		default:
+			dst := zoneByName(bs, zone)
+			*dst = append(*dst, card)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	name := "a card"
+	if publicZones[zone] || (reveal != nil && *reveal) {
+		name = card.Name
+	}
+	return updated, s.logEvents(
+		newEvent(gameID, "tutor", username, "%s searches their library and puts %s %s",
+			username, name, libraryDestinations[zone]),
+		shuffle,
+	)
+}
+
+// PutInLibrary puts cards on the top or the bottom of their owner's library,
+// in the order they're listed from the top down. position is "top" or
+// "bottom". The cards can be in any of the player's zones, or be
+// permanents they control, and stay visible to the player in the library.
+func (s *graphQLServer) PutInLibrary(ctx context.Context, gameID string, username string, cardIDs []string, position string) (*BoardState, error) {
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		if position != "top" && position != "bottom" {
+			return ErrBoardState.New("position must be top or bottom, not %s", position)
+		}
+
+		from := append(ownedZones(updated), &updated.Field, &updated.Controlled)
+		put := map[*BoardState][]*Card{}
+		var owners []*BoardState
+		for _, id := range cardIDs {
+			card := takeCard(from, id)
+			if card == nil {
+				return ErrBoardState.New("card %s is not in one of %s's zones", id, username)
+			}
+			leaveBattlefield(card)
+			card.Controller = nil
+			dst, ok := boards[owner(card)]
+			if !ok {
+				dst = updated
+			}
+			if _, ok := put[dst]; !ok {
+				owners = append(owners, dst)
+			}
+			showTo([]*Card{card}, username)
+			put[dst] = append(put[dst], card)
+		}
+
+		for _, bs := range owners {
+			if position == "top" {
+				bs.Library = append(put[bs], bs.Library...)
+			} else {
+				bs.Library = append(bs.Library, put[bs]...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), s.logEvents(newEvent(gameID, "library", username, "%s puts %d cards on the %s of their library", username, len(cardIDs), position))
+}
+
+// libraryDestinations are the zones a card from the library can be put
+// into, and how the event log describes putting a card there.
+var libraryDestinations = map[string]string{
+	"Hand":      "into their hand",
+	"Field":     "onto the battlefield",
+	"Graveyard": "into their graveyard",
+	"Exiled":    "into exile",
+	"Revealed":  "with their revealed cards",
+	"Library":   "on top of their library",
+}
+
+// publicZones are the zones every player can see.
+var publicZones = map[string]bool{
+	"Field":     true,
+	"Graveyard": true,
+	"Exiled":    true,
+	"Revealed":  true,
+}
+
+// zoneByName returns the zone of a board with the given name, or nil.
+func zoneByName(bs *BoardState, name string) *[]*Card {
+	switch name {
+	case "Commander":
+		return &bs.Commander
+	case "Library":
+		return &bs.Library
+	case "Graveyard":
+		return &bs.Graveyard
+	case "Exiled":
+		return &bs.Exiled
+	case "Field":
+		return &bs.Field
+	case "Hand":
+		return &bs.Hand
+	case "Controlled":
+		return &bs.Controlled
+	case "Revealed":
+		return &bs.Revealed
+	}
+	return nil
+}
+
+// topOfLibrary returns the top cards of the player's library, or all of
+// them if the library has fewer.
+func topOfLibrary(bs *BoardState, amount int) ([]*Card, error) {
+	if amount < 1 {
+		return nil, ErrBoardState.New("must look at at least 1 card")
+	}
+	if amount > len(bs.Library) {
+		amount = len(bs.Library)
+	}
+	return bs.Library[:amount], nil
+}
+
+// showTo lets the players see cards in a library, where cards are hidden
+// from everyone, until the library is shuffled.
+func showTo(cards []*Card, players ...string) {
+	for _, c := range cards {
+		for _, p := range players {
+			addViewer(c, p)
+		}
+	}
+}
+
+// splitTop divides the top len(a)+len(b) cards of a library by the instance
+// IDs in a and b, in the order they're listed, and returns the rest of the
+// library. Every one of the top cards must be listed once.
+func splitTop(library []*Card, a []string, b []string) ([]*Card, []*Card, []*Card, error) {
+	n := len(a) + len(b)
+	if n > len(library) {
+		return nil, nil, nil, ErrBoardState.New("the library only has %d cards", len(library))
+	}
+	top := library[:n]
+	seen := map[string]bool{}
+	pick := func(ids []string) ([]*Card, error) {
+		out := []*Card{}
+		for _, id := range ids {
+			card := findCard(top, id)
+			if card == nil {
+				return nil, ErrBoardState.New("card %s is not one of the top %d cards of the library", id, n)
+			}
+			if seen[id] {
+				return nil, ErrBoardState.New("card %s is listed more than once", id)
+			}
+			seen[id] = true
+			out = append(out, card)
+		}
+		return out, nil
+	}
+	first, err := pick(a)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	second, err := pick(b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return first, second, append([]*Card(nil), library[n:]...), nil
+}
+
+// cardNames lists the names of cards for the game's event log.
+func cardNames(cards []*Card) string {
+	names := make([]string, 0, len(cards))
+	for _, c := range cards {
+		names = append(names, c.Name)
+	}
+	if len(names) == 0 {
+		return "no cards"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibrary(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	names := func(zone []*Card) []string {
+		out := []string{}
+		for _, c := range zone {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+	library := func() []*Card {
+		return []*Card{
+			testCard(t, s, "Serra Angel"),
+			testCard(t, s, "Swamp"),
+			testCard(t, s, "Lim-Dûl's Vault"),
+			testCard(t, s, "Teysa Karlov"),
+			testCard(t, s, "Delver of Secrets"),
+		}
+	}
+	lastEvent := func(t *testing.T) *GameEvent {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		return events[len(events)-1]
+	}
+	logged := func(t *testing.T, text string) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		texts := []string{}
+		for _, e := range events {
+			texts = append(texts, e.Text)
+		}
+		assert.Contains(t, texts, text)
+	}
+
+	t.Run("test looks at and reveals the top cards", func(t *testing.T) {
+		putBoard(t, s, "alice", &BoardState{Library: library()})
+
+		top, err := s.LookAtTop(ctx, "game", "alice", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel", "Swamp"}, names(top))
+		assert.Equal(t, "alice looks at the top 2 cards of their library", lastEvent(t).Text)
+
+		top, err = s.RevealTop(ctx, "game", "alice", 10)
+		assert.NoError(t, err)
+		assert.Len(t, top, 5)
+		assert.Contains(t, lastEvent(t).Text, "alice reveals Serra Angel, Swamp, Lim-Dûl's Vault")
+
+		_, err = s.LookAtTop(ctx, "game", "alice", 0)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test scry", func(t *testing.T) {
+		lib := library()
+		putBoard(t, s, "alice", &BoardState{Library: lib})
+
+		_, err := s.Scry(ctx, "game", "alice", []string{*lib[0].InstanceID}, []string{*lib[2].InstanceID})
+		assert.True(t, ErrBoardState.Has(err), "not the top two")
+		_, err = s.Scry(ctx, "game", "alice", []string{*lib[0].InstanceID}, []string{*lib[0].InstanceID})
+		assert.True(t, ErrBoardState.Has(err), "listed twice")
+
+		bs, err := s.Scry(ctx, "game", "alice", []string{*lib[2].InstanceID, *lib[0].InstanceID}, []string{*lib[1].InstanceID})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Lim-Dûl's Vault", "Serra Angel", "a card", "a card", "Swamp"}, names(bs.Library), "only the scried cards are shown")
+		assert.Equal(t, "alice scries 3, keeping 2 on top and putting 1 on the bottom", lastEvent(t).Text)
+	})
+
+	t.Run("test surveil and mill", func(t *testing.T) {
+		lib := library()
+		putBoard(t, s, "alice", &BoardState{Library: lib})
+
+		bs, err := s.Surveil(ctx, "game", "alice", []string{*lib[1].InstanceID}, []string{*lib[0].InstanceID})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Swamp", "a card", "a card", "a card"}, names(bs.Library))
+		assert.Equal(t, []string{"Serra Angel"}, names(bs.Graveyard))
+		assert.Equal(t, "alice surveils 2 and puts Serra Angel into their graveyard", lastEvent(t).Text)
+
+		bs, err = s.Mill(ctx, "game", "alice", 2)
+		assert.NoError(t, err)
+		assert.Len(t, bs.Library, 2)
+		assert.Equal(t, []string{"Serra Angel", "Swamp", "Lim-Dûl's Vault"}, names(bs.Graveyard))
+
+		bs, err = s.Mill(ctx, "game", "alice", 10)
+		assert.NoError(t, err)
+		assert.Len(t, bs.Library, 0)
+		assert.Len(t, bs.Graveyard, 5)
+	})
+
+	t.Run("test tutor", func(t *testing.T) {
+		lib := library()
+		putBoard(t, s, "alice", &BoardState{Library: lib})
+
+		_, err := s.Tutor(ctx, "game", "alice", *lib[3].InstanceID, "Sideboard", nil)
+		assert.True(t, ErrBoardState.Has(err))
+
+		bs, err := s.Tutor(ctx, "game", "alice", *lib[3].InstanceID, "Hand", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Teysa Karlov"}, names(bs.Hand))
+		assert.Len(t, bs.Library, 4)
+		logged(t, "alice searches their library and puts a card into their hand")
+		assert.Equal(t, "shuffle", lastEvent(t).Kind)
+
+		bs, err = s.Tutor(ctx, "game", "alice", *lib[0].InstanceID, "Field", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel"}, names(bs.Field))
+		logged(t, "alice searches their library and puts Serra Angel onto the battlefield")
+
+		yes := true
+		bs, err = s.Tutor(ctx, "game", "alice", *lib[2].InstanceID, "Library", &yes)
+		assert.NoError(t, err)
+		assert.Equal(t, "Lim-Dûl's Vault", bs.Library[0].Name)
+		assert.Len(t, bs.Library, 3)
+
+		_, err = s.Tutor(ctx, "game", "alice", *lib[3].InstanceID, "Hand", nil)
+		assert.True(t, ErrBoardState.Has(err), "already tutored")
+	})
+
+	t.Run("test puts cards on top and bottom in order", func(t *testing.T) {
+		lib := library()
+		hand := library()
+		stolen := testCard(t, s, "Bonecrusher Giant")
+		putBoard(t, s, "alice", &BoardState{Library: lib[:2], Hand: hand[:2]})
+		putBoard(t, s, "bob", &BoardState{Field: []*Card{stolen}})
+		_, err := s.GainControl(ctx, "game", "alice", *stolen.InstanceID)
+		assert.NoError(t, err)
+
+		_, err = s.PutInLibrary(ctx, "game", "alice", []string{*hand[0].InstanceID}, "middle")
+		assert.True(t, ErrBoardState.Has(err))
+
+		bs, err := s.PutInLibrary(ctx, "game", "alice", []string{*hand[1].InstanceID, *hand[0].InstanceID}, "top")
+		assert.NoError(t, err)
+		assert.Len(t, bs.Hand, 0)
+		assert.Equal(t, []string{"Swamp", "Serra Angel", "a card", "a card"}, names(bs.Library))
+
+		bs, err = s.PutInLibrary(ctx, "game", "alice", []string{*bs.Library[0].InstanceID, *stolen.InstanceID}, "bottom")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel", "a card", "a card", "Swamp"}, names(bs.Library))
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel", "Serra Angel", "Swamp", "Swamp"}, names(stored.Library))
+		assert.Len(t, bs.Controlled, 0)
+
+		bob, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Bonecrusher Giant // Stomp"}, names(bob.Library))
+		assert.Nil(t, bob.Library[0].Controller)
+	})
+	t.Run("test hides libraries and hands", func(t *testing.T) {
+		lib := library()
+		hand := library()
+		putBoard(t, s, "alice", &BoardState{Library: lib, Hand: hand[:1]})
+		seenBy := func(t *testing.T, viewer string) *BoardState {
+			boards, err := s.Boardstates(ctx, "game", str("alice"), &viewer)
+			assert.NoError(t, err)
+			return boards[0]
+		}
+
+		bob := seenBy(t, "bob")
+		assert.Equal(t, []string{"a card", "a card", "a card", "a card", "a card"}, names(bob.Library))
+		assert.Equal(t, []string{"a card"}, names(bob.Hand))
+		assert.Equal(t, "", bob.Hand[0].ID)
+		assert.Nil(t, bob.Hand[0].Text)
+		assert.Equal(t, []string{"Serra Angel"}, names(seenBy(t, "alice").Hand))
+
+		_, err := s.LookAtTop(ctx, "game", "alice", 1)
+		assert.NoError(t, err)
+		_, err = s.Scry(ctx, "game", "alice", []string{*lib[1].InstanceID}, []string{*lib[0].InstanceID})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Swamp", "a card", "a card", "a card", "Serra Angel"}, names(seenBy(t, "alice").Library))
+		assert.Equal(t, []string{"a card", "a card", "a card", "a card", "a card"}, names(seenBy(t, "bob").Library), "bob can't see the scry")
+
+		_, err = s.RevealTop(ctx, "game", "alice", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "Swamp", seenBy(t, "bob").Library[0].Name)
+
+		_, err = s.ShuffleLibrary(ctx, "game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a card", "a card", "a card", "a card", "a card"}, names(seenBy(t, "alice").Library))
+	})
+
+	t.Run("test keeps hidden cards the client moves", func(t *testing.T) {
+		lib := library()
+		putBoard(t, s, "alice", &BoardState{Library: lib})
+		boards, err := s.Boardstates(ctx, "game", str("alice"), str("alice"))
+		assert.NoError(t, err)
+		drawn := boards[0].Library[0]
+		assert.Equal(t, "a card", drawn.Name)
+
+		bs, err := s.UpdateBoardState(ctx, InputBoardState{
+			User:    &InputUser{Username: "alice"},
+			GameID:  "game",
+			Library: []*InputCard{{Name: "a card", InstanceID: boards[0].Library[1].InstanceID}},
+			Hand:    []*InputCard{{Name: drawn.Name, InstanceID: drawn.InstanceID}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Serra Angel"}, names(bs.Hand))
+		stored, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Swamp"}, names(stored.Library))
+	})
+}
@@ -7,46 +7,109 @@ import (
 )
 
 type BoardState struct {
-	User       *User      `json:"User"`
-	Life       int        `json:"Life"`
-	GameID     string     `json:"GameID"`
-	Commander  []*Card    `json:"Commander"`
-	Library    []*Card    `json:"Library"`
-	Graveyard  []*Card    `json:"Graveyard"`
-	Exiled     []*Card    `json:"Exiled"`
-	Field      []*Card    `json:"Field"`
-	Hand       []*Card    `json:"Hand"`
-	Revealed   []*Card    `json:"Revealed"`
-	Controlled []*Card    `json:"Controlled"`
-	Counters   []*Counter `json:"Counters"`
+	User            *User              `json:"User"`
+	Life            int                `json:"Life"`
+	GameID          string             `json:"GameID"`
+	Commander       []*Card            `json:"Commander"`
+	Library         []*Card            `json:"Library"`
+	Graveyard       []*Card            `json:"Graveyard"`
+	Exiled          []*Card            `json:"Exiled"`
+	Field           []*Card            `json:"Field"`
+	Hand            []*Card            `json:"Hand"`
+	Revealed        []*Card            `json:"Revealed"`
+	Controlled      []*Card            `json:"Controlled"`
+	Counters        []*Counter         `json:"Counters"`
+	CommanderDamage []*CommanderDamage `json:"CommanderDamage"`
+	Emblems         []*Emblem          `json:"Emblems"`
 }
 
 type Card struct {
-	Name          string     `json:"Name"`
-	ID            string     `json:"ID"`
-	Quantity      *int       `json:"Quantity"`
-	Tapped        *bool      `json:"Tapped"`
-	Flipped       *bool      `json:"Flipped"`
-	Counters      []*Counter `json:"Counters"`
-	Colors        *string    `json:"Colors"`
-	ColorIdentity *string    `json:"ColorIdentity"`
-	Cmc           *string    `json:"CMC"`
-	ManaCost      *string    `json:"ManaCost"`
-	UUID          *string    `json:"UUID"`
-	Power         *string    `json:"Power"`
-	Toughness     *string    `json:"Toughness"`
-	Types         *string    `json:"Types"`
-	Subtypes      *string    `json:"Subtypes"`
-	Supertypes    *string    `json:"Supertypes"`
-	IsTextless    *string    `json:"IsTextless"`
-	Text          *string    `json:"Text"`
-	Tcgid         *string    `json:"TCGID"`
-	ScryfallID    *string    `json:"ScryfallID"`
+	Name          string      `json:"Name"`
+	ID            string      `json:"ID"`
+	InstanceID    *string     `json:"InstanceID"`
+	Quantity      *int        `json:"Quantity"`
+	Tapped        *bool       `json:"Tapped"`
+	Flipped       *bool       `json:"Flipped"`
+	FaceDown      *bool       `json:"FaceDown"`
+	Viewers       []string    `json:"Viewers"`
+	Counters      []*Counter  `json:"Counters"`
+	Labels        []*Label    `json:"Labels"`
+	AttachedTo    *string     `json:"AttachedTo"`
+	IsToken       *bool       `json:"IsToken"`
+	Owner         *string     `json:"Owner"`
+	Controller    *string     `json:"Controller"`
+	IsCommander   *bool       `json:"IsCommander"`
+	Attacking     *string     `json:"Attacking"`
+	Blocking      []string    `json:"Blocking"`
+	Damage        *int        `json:"Damage"`
+	LethalDamage  *bool       `json:"LethalDamage"`
+	Colors        *string     `json:"Colors"`
+	ColorIdentity *string     `json:"ColorIdentity"`
+	Cmc           *string     `json:"CMC"`
+	ManaCost      *string     `json:"ManaCost"`
+	UUID          *string     `json:"UUID"`
+	Power         *string     `json:"Power"`
+	Toughness     *string     `json:"Toughness"`
+	Loyalty       *string     `json:"Loyalty"`
+	Types         *string     `json:"Types"`
+	Subtypes      *string     `json:"Subtypes"`
+	Supertypes    *string     `json:"Supertypes"`
+	IsTextless    *string     `json:"IsTextless"`
+	Text          *string     `json:"Text"`
+	Tcgid         *string     `json:"TCGID"`
+	ScryfallID    *string     `json:"ScryfallID"`
+	Layout        *string     `json:"Layout"`
+	FaceName      *string     `json:"FaceName"`
+	Faces         []*CardFace `json:"Faces"`
+	Set           *string     `json:"Set"`
+	Number        *string     `json:"Number"`
+}
+
+type CardFace struct {
+	Name       string  `json:"Name"`
+	ManaCost   *string `json:"ManaCost"`
+	Cmc        *string `json:"CMC"`
+	Colors     *string `json:"Colors"`
+	Power      *string `json:"Power"`
+	Toughness  *string `json:"Toughness"`
+	Loyalty    *string `json:"Loyalty"`
+	Types      *string `json:"Types"`
+	Subtypes   *string `json:"Subtypes"`
+	Supertypes *string `json:"Supertypes"`
+	Text       *string `json:"Text"`
+}
+
+type CardResolution struct {
+	Query       string   `json:"Query"`
+	Match       string   `json:"Match"`
+	Card        *Card    `json:"Card"`
+	Suggestions []string `json:"Suggestions"`
+}
+
+type CombatDamage struct {
+	Damage []*DamageEvent `json:"Damage"`
+	Boards []*BoardState  `json:"Boards"`
+}
+
+type CommanderDamage struct {
+	Commander string `json:"Commander"`
+	Name      string `json:"Name"`
+	Amount    int    `json:"Amount"`
 }
 
 type Counter struct {
-	Name  string `json:"Name"`
-	Value string `json:"Value"`
+	Name       string  `json:"Name"`
+	Value      string  `json:"Value"`
+	AssignedBy *string `json:"AssignedBy"`
+}
+
+type DamageEvent struct {
+	Source     string `json:"Source"`
+	SourceName string `json:"SourceName"`
+	Target     string `json:"Target"`
+	TargetName string `json:"TargetName"`
+	Amount     int    `json:"Amount"`
+	Commander  bool   `json:"Commander"`
 }
 
 type Deck struct {
@@ -56,6 +119,12 @@ type Deck struct {
 	Library   []string `json:"Library"`
 }
 
+type Designation struct {
+	Name   string  `json:"Name"`
+	Player *string `json:"Player"`
+	Value  *string `json:"Value"`
+}
+
 type Emblem struct {
 	Name   string `json:"Name"`
 	Value  string `json:"Value"`
@@ -63,59 +132,126 @@ type Emblem struct {
 }
 
 type Game struct {
+	ID           string         `json:"ID"`
+	CreatedAt    time.Time      `json:"CreatedAt"`
+	Handle       *string        `json:"Handle"`
+	Rules        []*Rule        `json:"Rules"`
+	Turn         *Turn          `json:"Turn"`
+	PlayerIDs    []*User        `json:"PlayerIDs"`
+	Stack        []*StackItem   `json:"Stack"`
+	Priority     *string        `json:"Priority"`
+	Passed       []string       `json:"Passed"`
+	Seats        []*Seat        `json:"Seats"`
+	Reversed     *bool          `json:"Reversed"`
+	ExtraTurns   []string       `json:"ExtraTurns"`
+	SpellsCast   *int           `json:"SpellsCast"`
+	Designations []*Designation `json:"Designations"`
+}
+
+type GameEvent struct {
 	ID        string    `json:"ID"`
+	GameID    string    `json:"GameID"`
+	Kind      string    `json:"Kind"`
+	Player    *string   `json:"Player"`
+	Text      string    `json:"Text"`
 	CreatedAt time.Time `json:"CreatedAt"`
-	Handle    *string   `json:"Handle"`
-	Rules     []*Rule   `json:"Rules"`
-	Turn      *Turn     `json:"Turn"`
-	PlayerIDs []*User   `json:"PlayerIDs"`
+}
+
+type InputAttacker struct {
+	CardID   string `json:"CardID"`
+	Defender string `json:"Defender"`
+}
+
+type InputBlocker struct {
+	CardID     string `json:"CardID"`
+	AttackerID string `json:"AttackerID"`
 }
 
 type InputBoardState struct {
-	User       *InputUser      `json:"User"`
-	GameID     string          `json:"GameID"`
-	Life       int             `json:"Life"`
-	Decklist   *string         `json:"Decklist"`
-	Commander  []*InputCard    `json:"Commander"`
-	Library    []*InputCard    `json:"Library"`
-	Graveyard  []*InputCard    `json:"Graveyard"`
-	Exiled     []*InputCard    `json:"Exiled"`
-	Field      []*InputCard    `json:"Field"`
-	Hand       []*InputCard    `json:"Hand"`
-	Revealed   []*InputCard    `json:"Revealed"`
-	Controlled []*InputCard    `json:"Controlled"`
-	Counters   []*InputCounter `json:"Counters"`
-	Emblems    []*InputEmblem  `json:"Emblems"`
+	User            *InputUser              `json:"User"`
+	GameID          string                  `json:"GameID"`
+	Life            int                     `json:"Life"`
+	Decklist        *string                 `json:"Decklist"`
+	Commander       []*InputCard            `json:"Commander"`
+	Library         []*InputCard            `json:"Library"`
+	Graveyard       []*InputCard            `json:"Graveyard"`
+	Exiled          []*InputCard            `json:"Exiled"`
+	Field           []*InputCard            `json:"Field"`
+	Hand            []*InputCard            `json:"Hand"`
+	Revealed        []*InputCard            `json:"Revealed"`
+	Controlled      []*InputCard            `json:"Controlled"`
+	Counters        []*InputCounter         `json:"Counters"`
+	CommanderDamage []*InputCommanderDamage `json:"CommanderDamage"`
+	Emblems         []*InputEmblem          `json:"Emblems"`
 }
 
 type InputCard struct {
-	ID            *string         `json:"ID"`
-	Name          string          `json:"Name"`
-	Counters      []*InputCounter `json:"Counters"`
-	Labels        []*InputLabel   `json:"Labels"`
-	Tapped        *bool           `json:"Tapped"`
-	Flipped       *bool           `json:"Flipped"`
-	Quantity      *int            `json:"Quantity"`
-	Colors        *string         `json:"Colors"`
-	ColorIdentity *string         `json:"ColorIdentity"`
-	Cmc           *string         `json:"CMC"`
-	ManaCost      *string         `json:"ManaCost"`
-	UUID          *string         `json:"UUID"`
-	Power         *string         `json:"Power"`
-	Toughness     *string         `json:"Toughness"`
-	Types         *string         `json:"Types"`
-	Subtypes      *string         `json:"Subtypes"`
-	Supertypes    *string         `json:"Supertypes"`
-	IsTextless    *string         `json:"IsTextless"`
-	Text          *string         `json:"Text"`
-	Tcgid         *string         `json:"TCGID"`
-	ScryfallID    *string         `json:"ScryfallID"`
+	ID            *string          `json:"ID"`
+	InstanceID    *string          `json:"InstanceID"`
+	Name          string           `json:"Name"`
+	Counters      []*InputCounter  `json:"Counters"`
+	Labels        []*InputLabel    `json:"Labels"`
+	AttachedTo    *string          `json:"AttachedTo"`
+	IsToken       *bool            `json:"IsToken"`
+	Owner         *string          `json:"Owner"`
+	Controller    *string          `json:"Controller"`
+	IsCommander   *bool            `json:"IsCommander"`
+	Attacking     *string          `json:"Attacking"`
+	Blocking      []string         `json:"Blocking"`
+	Damage        *int             `json:"Damage"`
+	LethalDamage  *bool            `json:"LethalDamage"`
+	Tapped        *bool            `json:"Tapped"`
+	Flipped       *bool            `json:"Flipped"`
+	FaceDown      *bool            `json:"FaceDown"`
+	Viewers       []string         `json:"Viewers"`
+	Quantity      *int             `json:"Quantity"`
+	Colors        *string          `json:"Colors"`
+	ColorIdentity *string          `json:"ColorIdentity"`
+	Cmc           *string          `json:"CMC"`
+	ManaCost      *string          `json:"ManaCost"`
+	UUID          *string          `json:"UUID"`
+	Power         *string          `json:"Power"`
+	Toughness     *string          `json:"Toughness"`
+	Loyalty       *string          `json:"Loyalty"`
+	Types         *string          `json:"Types"`
+	Subtypes      *string          `json:"Subtypes"`
+	Supertypes    *string          `json:"Supertypes"`
+	IsTextless    *string          `json:"IsTextless"`
+	Text          *string          `json:"Text"`
+	Tcgid         *string          `json:"TCGID"`
+	ScryfallID    *string          `json:"ScryfallID"`
+	Layout        *string          `json:"Layout"`
+	FaceName      *string          `json:"FaceName"`
+	Faces         []*InputCardFace `json:"Faces"`
+	Set           *string          `json:"Set"`
+	Number        *string          `json:"Number"`
+}
+
+type InputCardFace struct {
+	Name       string  `json:"Name"`
+	ManaCost   *string `json:"ManaCost"`
+	Cmc        *string `json:"CMC"`
+	Colors     *string `json:"Colors"`
+	Power      *string `json:"Power"`
+	Toughness  *string `json:"Toughness"`
+	Loyalty    *string `json:"Loyalty"`
+	Types      *string `json:"Types"`
+	Subtypes   *string `json:"Subtypes"`
+	Supertypes *string `json:"Supertypes"`
+	Text       *string `json:"Text"`
+}
+
+type InputCommanderDamage struct {
+	Commander string `json:"Commander"`
+	Name      string `json:"Name"`
+	Amount    int    `json:"Amount"`
 }
 
 type InputCounter struct {
-	Card  *InputCard `json:"Card"`
-	Name  string     `json:"Name"`
-	Value string     `json:"Value"`
+	Card       *InputCard `json:"Card"`
+	Name       string     `json:"Name"`
+	Value      string     `json:"Value"`
+	AssignedBy *string    `json:"AssignedBy"`
 }
 
 type InputCreateGame struct {
@@ -151,12 +287,29 @@ type InputLabel struct {
 	AssignedBy string `json:"AssignedBy"`
 }
 
+type InputRange struct {
+	Min *float64 `json:"Min"`
+	Max *float64 `json:"Max"`
+}
+
 type InputSignup struct {
 	Username string `json:"Username"`
 	Email    string `json:"Email"`
 	Password string `json:"Password"`
 }
 
+type InputToken struct {
+	Name       string  `json:"Name"`
+	UUID       *string `json:"UUID"`
+	Colors     *string `json:"Colors"`
+	Power      *string `json:"Power"`
+	Toughness  *string `json:"Toughness"`
+	Types      *string `json:"Types"`
+	Subtypes   *string `json:"Subtypes"`
+	Supertypes *string `json:"Supertypes"`
+	Text       *string `json:"Text"`
+}
+
 type InputTurn struct {
 	Player string `json:"Player"`
 	Phase  string `json:"Phase"`
@@ -168,6 +321,12 @@ type InputUser struct {
 	ID       *string `json:"ID"`
 }
 
+type Label struct {
+	Name       string `json:"Name"`
+	Value      string `json:"Value"`
+	AssignedBy string `json:"AssignedBy"`
+}
+
 type Message struct {
 	ID        string    `json:"ID"`
 	User      string    `json:"User"`
@@ -177,11 +336,43 @@ type Message struct {
 	Channel   *string   `json:"Channel"`
 }
 
+type RandomResult struct {
+	Results []string   `json:"Results"`
+	Total   *int       `json:"Total"`
+	Event   *GameEvent `json:"Event"`
+}
+
 type Rule struct {
 	Name  string `json:"Name"`
 	Value string `json:"Value"`
 }
 
+type Seat struct {
+	Position   int    `json:"Position"`
+	Username   string `json:"Username"`
+	Eliminated bool   `json:"Eliminated"`
+}
+
+type ShuffleRecord struct {
+	ID         string    `json:"ID"`
+	GameID     string    `json:"GameID"`
+	Player     string    `json:"Player"`
+	Commitment string    `json:"Commitment"`
+	Seed       *string   `json:"Seed"`
+	Before     []string  `json:"Before"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+}
+
+type StackItem struct {
+	ID          string   `json:"ID"`
+	Kind        string   `json:"Kind"`
+	Controller  string   `json:"Controller"`
+	Description string   `json:"Description"`
+	Targets     []string `json:"Targets"`
+	Card        *Card    `json:"Card"`
+	Source      *string  `json:"Source"`
+}
+
 type Turn struct {
 	Player string `json:"Player"`
 	Phase  string `json:"Phase"`
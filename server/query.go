@@ -0,0 +1,378 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
+)
+
+// ErrQuery is returned when a search query can't be parsed.
+var ErrQuery = errs.Class("invalid search query")
+
+// SearchQuery searches for cards with a Scryfall-style query string like
+// `t:creature c:rg cmc<=3 o:"draw a card"`.
+func (s *graphQLServer) SearchQuery(ctx context.Context, q string, limit *int, offset *int) ([]*Card, error) {
+	filter, err := ParseSearchQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+
+	printings, err := s.cards.Search(filter)
+	if err != nil {
+		return nil, errs.New("failed to search cardDB: %s", err)
+	}
+	return cardsFromModels(printings), nil
+}
+
+// searchKeys maps every supported key and its aliases to its canonical name.
+var searchKeys = map[string]string{
+	"name":      "name",
+	"t":         "type",
+	"type":      "type",
+	"o":         "oracle",
+	"oracle":    "oracle",
+	"k":         "keyword",
+	"kw":        "keyword",
+	"keyword":   "keyword",
+	"c":         "color",
+	"color":     "color",
+	"id":        "identity",
+	"ci":        "identity",
+	"identity":  "identity",
+	"cmc":       "cmc",
+	"mv":        "cmc",
+	"manavalue": "cmc",
+	"pow":       "power",
+	"power":     "power",
+	"tou":       "toughness",
+	"toughness": "toughness",
+	"r":         "rarity",
+	"rarity":    "rarity",
+	"s":         "set",
+	"set":       "set",
+	"e":         "set",
+	"edition":   "set",
+}
+
+const searchKeyHelp = "supported keys are name, t, o, k, c, id, cmc, pow, tou, r, and s"
+
+// colorNames maps color words, guilds, shards, and wedges to their colors.
+var colorNames = map[string][]string{
+	"white":     {"W"},
+	"blue":      {"U"},
+	"black":     {"B"},
+	"red":       {"R"},
+	"green":     {"G"},
+	"colorless": {},
+	"azorius":   {"W", "U"},
+	"dimir":     {"U", "B"},
+	"rakdos":    {"B", "R"},
+	"gruul":     {"R", "G"},
+	"selesnya":  {"G", "W"},
+	"orzhov":    {"W", "B"},
+	"izzet":     {"U", "R"},
+	"golgari":   {"B", "G"},
+	"boros":     {"R", "W"},
+	"simic":     {"G", "U"},
+	"bant":      {"G", "W", "U"},
+	"esper":     {"W", "U", "B"},
+	"grixis":    {"U", "B", "R"},
+	"jund":      {"B", "R", "G"},
+	"naya":      {"R", "G", "W"},
+	"abzan":     {"W", "B", "G"},
+	"jeskai":    {"U", "R", "W"},
+	"sultai":    {"B", "G", "U"},
+	"mardu":     {"R", "W", "B"},
+	"temur":     {"G", "U", "R"},
+}
+
+var rarityNames = map[string]string{
+	"c":        "common",
+	"common":   "common",
+	"u":        "uncommon",
+	"uncommon": "uncommon",
+	"r":        "rare",
+	"rare":     "rare",
+	"m":        "mythic",
+	"mythic":   "mythic",
+	"s":        "special",
+	"special":  "special",
+}
+
+// searchTerm is a single term of a search query. Bare words have no key.
+type searchTerm struct {
+	pos     int
+	negated bool
+	key     string
+	op      string
+	value   string
+}
+
+// ParseSearchQuery parses a Scryfall-style search query into a card search
+// filter. Bare words and quoted phrases match card names, and every term
+// must match. Errors say where in the query the problem is.
+func ParseSearchQuery(q string) (cards.Filter, error) {
+	filter := cards.Filter{}
+
+	terms, err := tokenizeQuery(q)
+	if err != nil {
+		return filter, err
+	}
+	if len(terms) == 0 {
+		return filter, ErrQuery.New("must provide a search query")
+	}
+
+	for _, term := range terms {
+		if term.negated {
+			return filter, queryErr(term.pos, "negated terms like %q aren't supported", "-"+term.key+term.op+term.value)
+		}
+		if term.key == "" {
+			if strings.EqualFold(term.value, "or") || strings.ContainsAny(term.value, "()") {
+				return filter, queryErr(term.pos, "OR and parentheses aren't supported; every term must match")
+			}
+			filter.Names = append(filter.Names, term.value)
+			continue
+		}
+		if err := applyTerm(&filter, term); err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
+
+// applyTerm adds a keyed term to the filter.
+func applyTerm(filter *cards.Filter, term searchTerm) error {
+	key, ok := searchKeys[strings.ToLower(term.key)]
+	if !ok {
+		return queryErr(term.pos, "unknown key %q; %s", term.key, searchKeyHelp)
+	}
+	if term.value == "" {
+		return queryErr(term.pos, "%s%s needs a value", term.key, term.op)
+	}
+
+	switch key {
+	case "cmc", "power", "toughness":
+		n, err := strconv.ParseFloat(term.value, 64)
+		if err != nil {
+			return queryErr(term.pos, "%s needs a number; got %q", term.key, term.value)
+		}
+		op := term.op
+		if op == ":" {
+			op = "="
+		}
+		c := cards.Comparison{Op: op, Value: n}
+		switch key {
+		case "cmc":
+			filter.CMC = append(filter.CMC, c)
+		case "power":
+			filter.Power = append(filter.Power, c)
+		case "toughness":
+			filter.Toughness = append(filter.Toughness, c)
+		}
+		return nil
+	case "color", "identity":
+		return applyColorTerm(filter, key, term)
+	}
+
+	if term.op != ":" && term.op != "=" {
+		return queryErr(term.pos, "%s only supports : and =; got %s", term.key, term.op)
+	}
+
+	switch key {
+	case "name":
+		filter.Names = append(filter.Names, term.value)
+	case "type":
+		filter.Types = append(filter.Types, term.value)
+	case "oracle":
+		filter.Text = append(filter.Text, term.value)
+	case "keyword":
+		filter.Keywords = append(filter.Keywords, term.value)
+	case "set":
+		filter.Sets = append(filter.Sets, term.value)
+	case "rarity":
+		rarity, ok := rarityNames[strings.ToLower(term.value)]
+		if !ok {
+			return queryErr(term.pos, "unknown rarity %q; use common, uncommon, rare, or mythic", term.value)
+		}
+		filter.Rarities = append(filter.Rarities, rarity)
+	}
+	return nil
+}
+
+// applyColorTerm adds a color or color identity term. `c:` matches cards
+// with at least those colors, while `id:` matches cards that fit in a deck
+// of that identity, the same as Scryfall.
+func applyColorTerm(filter *cards.Filter, key string, term searchTerm) error {
+	colors, err := parseColors(term)
+	if err != nil {
+		return err
+	}
+
+	var match cards.ColorMatch
+	switch term.op {
+	case ":":
+		match = cards.ColorsInclude
+		if key == "identity" {
+			match = cards.ColorsWithin
+		}
+	case ">=":
+		match = cards.ColorsInclude
+	case "=":
+		match = cards.ColorsExactly
+	case "<=":
+		match = cards.ColorsWithin
+	default:
+		return queryErr(term.pos, "%s supports :, =, <=, and >=; got %s", term.key, term.op)
+	}
+
+	cf := &cards.ColorFilter{Colors: colors, Match: match}
+	if len(colors) == 0 {
+		// colorless only makes sense as an exact match
+		cf.Match = cards.ColorsExactly
+	}
+
+	if key == "color" {
+		if filter.Colors != nil {
+			return queryErr(term.pos, "colors can only be given once")
+		}
+		filter.Colors = cf
+	} else {
+		if filter.ColorIdentity != nil {
+			return queryErr(term.pos, "color identity can only be given once")
+		}
+		filter.ColorIdentity = cf
+	}
+	return nil
+}
+
+// parseColors reads color letters like `rg`, color words like `red`, or
+// guild, shard, and wedge names like `izzet`. `c` means colorless.
+func parseColors(term searchTerm) ([]string, error) {
+	v := strings.ToLower(term.value)
+	if colors, ok := colorNames[v]; ok {
+		return colors, nil
+	}
+	if v == "c" {
+		return []string{}, nil
+	}
+
+	colors := []string{}
+	seen := map[rune]bool{}
+	for _, r := range v {
+		if !strings.ContainsRune("wubrg", r) {
+			return nil, queryErr(term.pos, "unknown color %q; use letters from wubrg, c for colorless, or a name like izzet", term.value)
+		}
+		if !seen[r] {
+			seen[r] = true
+			colors = append(colors, strings.ToUpper(string(r)))
+		}
+	}
+	return colors, nil
+}
+
+// tokenizeQuery splits a query into terms. A term is a bare word, a quoted
+// phrase, or key, operator, and value like `o:"draw a card"`. A leading `-`
+// negates a term.
+func tokenizeQuery(q string) ([]searchTerm, error) {
+	runes := []rune(q)
+	terms := []searchTerm{}
+
+	i := 0
+	for i < len(runes) {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		term := searchTerm{pos: i}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			term.negated = true
+			i++
+		}
+
+		if runes[i] == '"' {
+			value, next, err := readQuoted(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			term.value = value
+			terms = append(terms, term)
+			i = next
+			continue
+		}
+
+		start := i
+		for i < len(runes) && unicode.IsLetter(runes[i]) {
+			i++
+		}
+		op := readOp(runes, i)
+		if op == "" || i == start {
+			// a bare word
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			term.value = string(runes[start:i])
+			terms = append(terms, term)
+			continue
+		}
+
+		term.key = string(runes[start:i])
+		term.op = op
+		i += len(op)
+		if i < len(runes) && runes[i] == '"' {
+			value, next, err := readQuoted(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			term.value = value
+			i = next
+		} else {
+			vstart := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			term.value = string(runes[vstart:i])
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, nil
+}
+
+// readOp returns the comparison operator starting at runes[i], if any.
+func readOp(runes []rune, i int) string {
+	for _, op := range []string{"!=", "<=", ">=", ":", "=", "<", ">"} {
+		if strings.HasPrefix(string(runes[i:]), op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// readQuoted reads a quoted phrase starting at the quote at runes[i] and
+// returns it with the index after the closing quote.
+func readQuoted(runes []rune, i int) (string, int, error) {
+	for j := i + 1; j < len(runes); j++ {
+		if runes[j] == '"' {
+			return string(runes[i+1 : j]), j + 1, nil
+		}
+	}
+	return "", 0, queryErr(i, "missing closing quote")
+}
+
+// queryErr returns an ErrQuery pointing at the 1-indexed column of pos.
+func queryErr(pos int, format string, args ...interface{}) error {
+	return ErrQuery.New("at column %d: "+format, append([]interface{}{pos + 1}, args...)...)
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dylanlott/edh-go/cards"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	cases := []struct {
+		query string
+		want  cards.Filter
+	}{
+		{
+			query: `t:creature c:rg cmc<=3 o:"draw a card"`,
+			want: cards.Filter{
+				Types:  []string{"creature"},
+				Colors: &cards.ColorFilter{Colors: []string{"R", "G"}, Match: cards.ColorsInclude},
+				CMC:    []cards.Comparison{{Op: "<=", Value: 3}},
+				Text:   []string{"draw a card"},
+			},
+		},
+		{
+			query: `serra angel`,
+			want:  cards.Filter{Names: []string{"serra", "angel"}},
+		},
+		{
+			query: `"lim-dûl" name:vault`,
+			want:  cards.Filter{Names: []string{"lim-dûl", "vault"}},
+		},
+		{
+			query: `id:esper pow>=4 tou!=5 mv=2`,
+			want: cards.Filter{
+				ColorIdentity: &cards.ColorFilter{Colors: []string{"W", "U", "B"}, Match: cards.ColorsWithin},
+				Power:         []cards.Comparison{{Op: ">=", Value: 4}},
+				Toughness:     []cards.Comparison{{Op: "!=", Value: 5}},
+				CMC:           []cards.Comparison{{Op: "=", Value: 2}},
+			},
+		},
+		{
+			query: `c=c`,
+			want:  cards.Filter{Colors: &cards.ColorFilter{Colors: []string{}, Match: cards.ColorsExactly}},
+		},
+		{
+			query: `C<=Izzet r:m s:RNA k:flying cmc:4`,
+			want: cards.Filter{
+				Colors:   &cards.ColorFilter{Colors: []string{"U", "R"}, Match: cards.ColorsWithin},
+				Rarities: []string{"mythic"},
+				Sets:     []string{"RNA"},
+				Keywords: []string{"flying"},
+				CMC:      []cards.Comparison{{Op: "=", Value: 4}},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			got, err := ParseSearchQuery(c.query)
+			assert.NoError(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+
+	errors := []struct {
+		query string
+		msg   string
+	}{
+		{``, "invalid search query: must provide a search query"},
+		{`t:creature foo:bar`, "invalid search query: at column 12: unknown key \"foo\"; supported keys are name, t, o, k, c, id, cmc, pow, tou, r, and s"},
+		{`o:"draw a card`, "invalid search query: at column 3: missing closing quote"},
+		{`cmc<=three`, "invalid search query: at column 1: cmc needs a number; got \"three\""},
+		{`c:purple`, "invalid search query: at column 1: unknown color \"purple\"; use letters from wubrg, c for colorless, or a name like izzet"},
+		{`c:r c:g`, "invalid search query: at column 5: colors can only be given once"},
+		{`c>r`, "invalid search query: at column 1: c supports :, =, <=, and >=; got >"},
+		{`t>creature`, "invalid search query: at column 1: t only supports : and =; got >"},
+		{`r:legendary`, "invalid search query: at column 1: unknown rarity \"legendary\"; use common, uncommon, rare, or mythic"},
+		{`-t:creature`, "invalid search query: at column 1: negated terms like \"-t:creature\" aren't supported"},
+		{`t:elf or t:goblin`, "invalid search query: at column 7: OR and parentheses aren't supported; every term must match"},
+		{`t:`, "invalid search query: at column 1: t: needs a value"},
+	}
+	for _, e := range errors {
+		t.Run(e.query, func(t *testing.T) {
+			_, err := ParseSearchQuery(e.query)
+			assert.True(t, ErrQuery.Has(err))
+			assert.EqualError(t, err, e.msg)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// maxRandomCount is the most dice or coins that can be thrown at once.
+const maxRandomCount = 100
+
+// RollDice rolls count dice with the given number of sides and logs the
+// results to the table.
+func (s *graphQLServer) RollDice(ctx context.Context, gameID string, username string, sides int, count *int) (*RandomResult, error) {
+	if _, err := s.findGame(gameID); err != nil {
+		return nil, err
+	}
+	n := amountOrOne(count)
+	if err := checkRandomCount(n); err != nil {
+		return nil, err
+	}
+	if sides < 2 {
+		return nil, ErrGame.New("dice need at least 2 sides")
+	}
+
+	out := &RandomResult{Results: []string{}}
+	total := 0
+	for i := 0; i < n; i++ {
+		roll, err := randomInt(sides)
+		if err != nil {
+			return nil, err
+		}
+		total += roll + 1
+		out.Results = append(out.Results, strconv.Itoa(roll+1))
+	}
+	out.Total = &total
+	out.Event = newEvent(gameID, "dice", username, "%s rolls %dd%d: %s (total %d)",
+		username, n, sides, strings.Join(out.Results, ", "), total)
+	return out, s.logEvents(out.Event)
+}
+
+// FlipCoin flips count coins and logs the results to the table.
+func (s *graphQLServer) FlipCoin(ctx context.Context, gameID string, username string, count *int) (*RandomResult, error) {
+	if _, err := s.findGame(gameID); err != nil {
+		return nil, err
+	}
+	n := amountOrOne(count)
+	if err := checkRandomCount(n); err != nil {
+		return nil, err
+	}
+
+	out := &RandomResult{Results: []string{}}
+	for i := 0; i < n; i++ {
+		flip, err := randomInt(2)
+		if err != nil {
+			return nil, err
+		}
+		side := "heads"
+		if flip == 1 {
+			side = "tails"
+		}
+		out.Results = append(out.Results, side)
+	}
+	coins := "a coin"
+	if n > 1 {
+		coins = strconv.Itoa(n) + " coins"
+	}
+	out.Event = newEvent(gameID, "coin", username, "%s flips %s: %s", username, coins, strings.Join(out.Results, ", "))
+	return out, s.logEvents(out.Event)
+}
+
+// RandomPlayer chooses a player still in the game at random, leaving out
+// the player choosing if opponentsOnly is true, and logs the choice to the
+// table.
+func (s *graphQLServer) RandomPlayer(ctx context.Context, gameID string, username string, opponentsOnly *bool) (*RandomResult, error) {
+	game, err := s.findGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []string
+	for _, p := range livingPlayers(game) {
+		if p != username || opponentsOnly == nil || !*opponentsOnly {
+			players = append(players, p)
+		}
+	}
+	if len(players) == 0 {
+		return nil, ErrGame.New("there are no players for %s to choose from", username)
+	}
+
+	i, err := randomInt(len(players))
+	if err != nil {
+		return nil, err
+	}
+	out := &RandomResult{Results: []string{players[i]}}
+	out.Event = newEvent(gameID, "random", username, "%s randomly chooses %s", username, players[i])
+	return out, s.logEvents(out.Event)
+}
+
+// checkRandomCount returns an error unless count dice or coins can be
+// thrown at once.
+func checkRandomCount(count int) error {
+	if count < 1 || count > maxRandomCount {
+		return ErrGame.New("count must be between 1 and %d", maxRandomCount)
+	}
+	return nil
+}
+
+// randomInt returns a uniform random int in [0, n) read from crypto/rand.
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandom(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	putBoard(t, s, "alice", &BoardState{Life: 40})
+	putBoard(t, s, "bob", &BoardState{Life: 40})
+	putBoard(t, s, "carol", &BoardState{Life: 40})
+
+	t.Run("test rolls dice", func(t *testing.T) {
+		count := 3
+		res, err := s.RollDice(ctx, "game", "alice", 20, &count)
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 3)
+		total := 0
+		for _, r := range res.Results {
+			n, err := strconv.Atoi(r)
+			assert.NoError(t, err)
+			assert.True(t, n >= 1 && n <= 20, r)
+			total += n
+		}
+		assert.Equal(t, total, *res.Total)
+		assert.Equal(t, "dice", res.Event.Kind)
+		assert.Contains(t, res.Event.Text, "alice rolls 3d20: ")
+
+		_, err = s.RollDice(ctx, "game", "alice", 1, nil)
+		assert.True(t, ErrGame.Has(err))
+		count = 0
+		_, err = s.RollDice(ctx, "game", "alice", 6, &count)
+		assert.True(t, ErrGame.Has(err))
+	})
+
+	t.Run("test rolls every side", func(t *testing.T) {
+		count := 100
+		res, err := s.RollDice(ctx, "game", "alice", 2, &count)
+		assert.NoError(t, err)
+		assert.Contains(t, res.Results, "1")
+		assert.Contains(t, res.Results, "2")
+	})
+
+	t.Run("test flips coins", func(t *testing.T) {
+		res, err := s.FlipCoin(ctx, "game", "bob", nil)
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 1)
+		assert.Contains(t, []string{"heads", "tails"}, res.Results[0])
+		assert.Nil(t, res.Total)
+		assert.Equal(t, "bob flips a coin: "+res.Results[0], res.Event.Text)
+	})
+
+	t.Run("test chooses a random player", func(t *testing.T) {
+		yes := true
+		for i := 0; i < 20; i++ {
+			res, err := s.RandomPlayer(ctx, "game", "alice", &yes)
+			assert.NoError(t, err)
+			assert.Contains(t, []string{"bob", "carol"}, res.Results[0])
+		}
+		res, err := s.RandomPlayer(ctx, "game", "alice", nil)
+		assert.NoError(t, err)
+		assert.Contains(t, []string{"alice", "bob", "carol"}, res.Results[0])
+
+		_, err = s.RandomPlayer(ctx, "missing", "alice", nil)
+		assert.True(t, ErrGame.Has(err))
+		_, err = s.RollDice(ctx, "missing", "alice", 6, nil)
+		assert.True(t, ErrGame.Has(err))
+		_, err = s.FlipCoin(ctx, "missing", "alice", nil)
+		assert.True(t, ErrGame.Has(err))
+	})
+
+	t.Run("test logs results to the table", func(t *testing.T) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		assert.Len(t, events, 24)
+		assert.Equal(t, "dice", events[0].Kind)
+		assert.Equal(t, "random", events[len(events)-1].Kind)
+	})
+
+	t.Run("test only chooses players still in the game", func(t *testing.T) {
+		_, err := s.EliminatePlayer(ctx, "game", "carol", nil)
+		assert.NoError(t, err)
+		for i := 0; i < 20; i++ {
+			res, err := s.RandomPlayer(ctx, "game", "mallory", nil)
+			assert.NoError(t, err)
+			assert.Contains(t, []string{"alice", "bob"}, res.Results[0])
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dylanlott/edh-go/cards"
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// newTestServer returns a server backed by an in-memory KV and a card
+// database imported from the cards package's test data. The returned func
+// cleans it up.
+func newTestServer(t testing.TB) (*graphQLServer, func()) {
+	dir, err := ioutil.TempDir("", "edhgo-server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	cardDB, err := persistence.NewSQLite(filepath.Join(dir, "cards.sqlite"))
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	f, err := cards.OpenImportFile("../cards/testdata/AllPrintings.json")
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := cards.Import(cardDB, f); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	s, err := NewGraphQLServer(persistence.NewMemory(), nil, cardDB)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return s, cleanup
+}
+
+// str returns a pointer to s, for optional string arguments.
+func str(s string) *string { return &s }
+
+// intPtr returns a pointer to n, for optional int arguments.
+func intPtr(n int) *int { return &n }
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/game"
+	"github.com/dylanlott/edh-go/persistence"
+)
+
+// Shuffler shuffles a deck and returns the seed it used, so that the
+// shuffle can be checked and replayed with ShuffleSeeded.
+type Shuffler func(deck []*Card) (shuffled []*Card, seed []byte, err error)
+
+// CryptoShuffler shuffles a deck with a seed read from crypto/rand. It's the
+// server's default Shuffler.
+func CryptoShuffler(deck []*Card) ([]*Card, []byte, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	return ShuffleSeeded(deck, seed), seed, nil
+}
+
+// SeededShuffler returns a Shuffler for tests and replays whose shuffles are
+// all determined by seed. The nth shuffle, counting from 0, uses the
+// SHA-256 hash of seed followed by n as a big endian uint64.
+func SeededShuffler(seed []byte) Shuffler {
+	var (
+		mu sync.Mutex
+		n  uint64
+	)
+	return func(deck []*Card) ([]*Card, []byte, error) {
+		mu.Lock()
+		h := sha256.New()
+		_, _ = h.Write(seed)
+		_ = binary.Write(h, binary.BigEndian, n)
+		n++
+		mu.Unlock()
+
+		next := h.Sum(nil)
+		return ShuffleSeeded(deck, next), next, nil
+	}
+}
+
+// Shuffle shuffles a deck with a seed read from crypto/rand.
+func Shuffle(deck []*Card) ([]*Card, error) {
+	shuffled, _, err := CryptoShuffler(deck)
+	return shuffled, err
+}
+
+// ShuffleSeeded shuffles the deck in place with game.SeededShuffle, so
+// that the same deck and seed always give the same order.
+func ShuffleSeeded(deck []*Card, seed []byte) []*Card {
+	game.SeededShuffle(len(deck), seed, func(i, j int) {
+		deck[i], deck[j] = deck[j], deck[i]
+	})
+	return deck
+}
+
+// ShufflesKey returns the KV key of the record of a game's shuffles.
+func ShufflesKey(gameID string) string {
+	return fmt.Sprintf("shuffles:%s", gameID)
+}
+
+// shufflesRevealedKey returns the KV key that's set once a game's shuffle
+// seeds are revealed.
+func shufflesRevealedKey(gameID string) string {
+	return fmt.Sprintf("%s:revealed", ShufflesKey(gameID))
+}
+
+// shufflesAgreedKey returns the KV key of the set of players who have asked
+// to reveal a game's shuffle seeds.
+func shufflesAgreedKey(gameID string) string {
+	return fmt.Sprintf("%s:agreed", ShufflesKey(gameID))
+}
+
+// shuffle shuffles one of the player's decks with the server's Shuffler and
+// records the seed it used and the deck's order before the shuffle, by
+// instance ID from the top down. Only a commitment to the seed, its SHA-256
+// hash, is shown until the seeds are revealed with RevealShuffles. The
+// returned event announces the commitment, and should be logged once the
+// shuffled deck is saved. Nobody can see the shuffled cards anymore, not
+// even the ones they looked at before.
+func (s *graphQLServer) shuffle(gameID, username string, deck []*Card) ([]*Card, *GameEvent, error) {
+	before := make([]string, 0, len(deck))
+	for _, c := range deck {
+		before = append(before, instanceID(c))
+		// nobody knows where the cards are anymore
+		c.Viewers = nil
+	}
+	shuffled, seed, err := s.shuffler(deck)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitment := sha256.Sum256(seed)
+	record := &ShuffleRecord{
+		ID:         ksuid.New().String(),
+		GameID:     gameID,
+		Player:     username,
+		Commitment: hex.EncodeToString(commitment[:]),
+		Before:     before,
+		CreatedAt:  time.Now().UTC(),
+	}
+	encoded := hex.EncodeToString(seed)
+	record.Seed = &encoded
+
+	p, err := json.Marshal(record)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	key := persistence.Key(ShufflesKey(gameID))
+	if _, err := s.kv.LPush(key, persistence.Value(p)); err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	if _, err := s.kv.Expire(key, 12*time.Hour); err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+
+	event := newEvent(gameID, "shuffle", username, "%s shuffles their library with seed commitment %s", username, record.Commitment)
+	return shuffled, event, nil
+}
+
+// ShuffleLibrary shuffles the player's library.
+func (s *graphQLServer) ShuffleLibrary(ctx context.Context, gameID string, username string) (*BoardState, error) {
+	var event *GameEvent
+	updated, err := s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		library, e, err := s.shuffle(gameID, username, bs.Library)
+		if err != nil {
+			return err
+		}
+		bs.Library, event = library, e
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, s.logEvents(event)
+}
+
+// Shuffles returns the record of a game's shuffles, oldest first. Seeds and
+// the orders before each shuffle are left out until they're revealed.
+func (s *graphQLServer) Shuffles(ctx context.Context, gameID string) ([]*ShuffleRecord, error) {
+	records, err := s.shuffleRecords(gameID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, err = s.kv.Get(persistence.Key(shufflesRevealedKey(gameID)))
+	if persistence.ErrNotFound.Has(err) {
+		for _, r := range records {
+			r.Seed = nil
+			r.Before = nil
+		}
+		return records, nil
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return records, nil
+}
+
+// RevealShuffles reveals the seed of every shuffle in the game, and of every
+// shuffle after it, along with the order of the library before each one, so
+// that players can check each seed against its commitment and replay the
+// shuffle with ShuffleSeeded. The seeds give away the order of every
+// library, so they're only revealed once the game is over or every player
+// still in it has asked to reveal them. Until then, asking is noted in the
+// game's event log and the records are returned without their seeds.
+func (s *graphQLServer) RevealShuffles(ctx context.Context, gameID string, username string) ([]*ShuffleRecord, error) {
+	game, err := s.findGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if findSeat(game, username) == nil {
+		return nil, ErrGame.New("%s isn't playing in the game", username)
+	}
+
+	agreedKey := persistence.Key(shufflesAgreedKey(gameID))
+	if _, err := s.kv.SAdd(agreedKey, persistence.Value(username)); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if _, err := s.kv.Expire(agreedKey, 12*time.Hour); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	members, err := s.kv.SMembers(agreedKey)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	agreed := map[string]bool{}
+	for _, m := range members {
+		agreed[string(m)] = true
+	}
+	living := livingPlayers(game)
+	everyone := true
+	for _, p := range living {
+		everyone = everyone && agreed[p]
+	}
+	if len(living) > 1 && !everyone {
+		records, err := s.Shuffles(ctx, gameID)
+		if err != nil {
+			return nil, err
+		}
+		return records, s.logEvents(newEvent(gameID, "seed", username, "%s asks to reveal the shuffle seeds", username))
+	}
+
+	key := persistence.Key(shufflesRevealedKey(gameID))
+	if _, err := s.kv.Put(key, persistence.Value("true")); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if _, err := s.kv.Expire(key, 12*time.Hour); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	records, err := s.shuffleRecords(gameID)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*GameEvent, 0, len(records))
+	for _, r := range records {
+		events = append(events, newEvent(gameID, "seed", r.Player, "the seed with commitment %s was %s", r.Commitment, *r.Seed))
+	}
+	return records, s.logEvents(events...)
+}
+
+// shuffleRecords loads the record of a game's shuffles, oldest first, with
+// their seeds.
+func (s *graphQLServer) shuffleRecords(gameID string) ([]*ShuffleRecord, error) {
+	res, err := s.kv.LRange(persistence.Key(ShufflesKey(gameID)), 0, -1)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	records := make([]*ShuffleRecord, 0, len(res))
+	for i := len(res) - 1; i >= 0; i-- {
+		r := &ShuffleRecord{}
+		if err := json.Unmarshal([]byte(res[i]), r); err != nil {
+			log.Printf("error unmarshaling shuffle record: %s", err)
+			continue
+		}
+		if r.Seed == nil {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
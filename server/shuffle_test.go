@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffleSeeded(t *testing.T) {
+	deck := func() []*Card {
+		return []*Card{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}, {Name: "f"}}
+	}
+	names := func(deck []*Card) string {
+		out := ""
+		for _, c := range deck {
+			out += c.Name
+		}
+		return out
+	}
+
+	t.Run("test shuffles the same as the game package", func(t *testing.T) {
+		assert.Equal(t, "bacfde", names(ShuffleSeeded(deck(), []byte("edh-go"))))
+	})
+
+	t.Run("test seeded shufflers replay", func(t *testing.T) {
+		a, b := SeededShuffler([]byte("replay")), SeededShuffler([]byte("replay"))
+		for i := 0; i < 3; i++ {
+			first, seedA, err := a(deck())
+			assert.NoError(t, err)
+			second, seedB, err := b(deck())
+			assert.NoError(t, err)
+			assert.Equal(t, seedA, seedB)
+			assert.Equal(t, names(first), names(second))
+			assert.Equal(t, names(first), names(ShuffleSeeded(deck(), seedA)))
+		}
+
+		_, first, _ := SeededShuffler([]byte("replay"))(deck())
+		_, other, _ := SeededShuffler([]byte("other"))(deck())
+		assert.NotEqual(t, first, other)
+	})
+
+	t.Run("test crypto shuffler keeps every card", func(t *testing.T) {
+		shuffled, seed, err := CryptoShuffler(deck())
+		assert.NoError(t, err)
+		assert.Len(t, seed, 32)
+		assert.ElementsMatch(t, []rune("abcdef"), []rune(names(shuffled)))
+	})
+}
+
+func TestShuffleRecords(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+	s.shuffler = SeededShuffler([]byte("test"))
+
+	library := []*Card{
+		testCard(t, s, "Serra Angel"),
+		testCard(t, s, "Swamp"),
+		testCard(t, s, "Lim-Dûl's Vault"),
+		testCard(t, s, "Teysa Karlov"),
+		testCard(t, s, "Delver of Secrets"),
+	}
+	putBoard(t, s, "alice", &BoardState{Library: library})
+	putBoard(t, s, "bob", &BoardState{})
+
+	bs, err := s.ShuffleLibrary(ctx, "game", "alice")
+	assert.NoError(t, err)
+	assert.Len(t, bs.Library, 5)
+
+	records, err := s.Shuffles(ctx, "game")
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "alice", records[0].Player)
+		assert.Nil(t, records[0].Seed, "seeds are secret until revealed")
+		assert.Nil(t, records[0].Before)
+	}
+
+	events, err := s.Events(ctx, "game")
+	assert.NoError(t, err)
+	assert.Equal(t, "shuffle", events[len(events)-1].Kind)
+	assert.Contains(t, events[len(events)-1].Text, records[0].Commitment)
+
+	_, err = s.RevealShuffles(ctx, "game", "mallory")
+	assert.True(t, ErrGame.Has(err), "only players can reveal")
+	_, err = s.RevealShuffles(ctx, "missing", "alice")
+	assert.True(t, ErrGame.Has(err))
+
+	revealed, err := s.RevealShuffles(ctx, "game", "alice")
+	assert.NoError(t, err)
+	if assert.Len(t, revealed, 1) {
+		assert.Nil(t, revealed[0].Seed, "bob hasn't agreed and the game isn't over")
+	}
+
+	revealed, err = s.RevealShuffles(ctx, "game", "bob")
+	assert.NoError(t, err)
+	if assert.Len(t, revealed, 1) {
+		seed, err := hex.DecodeString(*revealed[0].Seed)
+		assert.NoError(t, err)
+		commitment := sha256.Sum256(seed)
+		assert.Equal(t, revealed[0].Commitment, hex.EncodeToString(commitment[:]))
+
+		before := make([]*Card, 0, len(revealed[0].Before))
+		for _, id := range revealed[0].Before {
+			id := id
+			before = append(before, &Card{InstanceID: &id})
+		}
+		replayed := ShuffleSeeded(before, seed)
+		if assert.Len(t, replayed, len(bs.Library)) {
+			for i := range replayed {
+				assert.Equal(t, *replayed[i].InstanceID, *bs.Library[i].InstanceID)
+			}
+		}
+	}
+
+	records, err = s.Shuffles(ctx, "game")
+	assert.NoError(t, err)
+	assert.NotNil(t, records[0].Seed)
+}
+
+func TestRevealShufflesAfterTheGame(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	putBoard(t, s, "alice", &BoardState{Library: []*Card{testCard(t, s, "Swamp"), testCard(t, s, "Serra Angel")}})
+	putBoard(t, s, "bob", &BoardState{})
+	_, err := s.ShuffleLibrary(ctx, "game", "alice")
+	assert.NoError(t, err)
+
+	_, err = s.EliminatePlayer(ctx, "game", "bob", nil)
+	assert.NoError(t, err)
+	revealed, err := s.RevealShuffles(ctx, "game", "bob")
+	assert.NoError(t, err)
+	if assert.Len(t, revealed, 1) {
+		assert.NotNil(t, revealed[0].Seed)
+		assert.Len(t, revealed[0].Before, 2)
+	}
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+
+	"github.com/segmentio/ksuid"
+)
+
+// permanentTypes are the card types that are put onto the battlefield when
+// they resolve.
+var permanentTypes = []string{"Artifact", "Battle", "Creature", "Enchantment", "Land", "Planeswalker"}
+
+// CastSpell puts a card from one of the player's zones, usually their hand
+// or command zone, on top of the game's stack. description defaults to the
+// card's name, and targets are free text, like a username or a card's
+// instance ID. cardID is the card's instance ID.
+//
+// A spell cast face down, like a morph or disguise creature, gets a new
+// instance ID and stays face down on the stack and when it resolves. Only
+// its controller can see what it is; to everyone else, and in the event
+// log, it's a face-down 2/2 creature spell.
+//
+// While the stack has something on it, only the player with priority can
+// add to it. The player who adds to the stack gets priority. Spells the
+// active player casts are counted in SpellsCast for day and night.
+func (s *graphQLServer) CastSpell(ctx context.Context, gameID string, username string, cardID string, description *string, targets []string, faceDown *bool) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		bs, ok := boards[username]
+		if !ok {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		if err := checkPriority(game, username); err != nil {
+			return err
+		}
+		card := takeCard(ownedZones(bs), cardID)
+		if card == nil {
+			return ErrBoardState.New("card %s is not in one of %s's zones", cardID, username)
+		}
+		if faceDown != nil && *faceDown {
+			down := true
+			card.FaceDown = &down
+			card.Viewers = nil
+			hideIdentity(card, boards)
+		} else {
+			turnFaceUp(card)
+			if hasType(card, "Land") {
+				return ErrBoardState.New("%s is a land and can't be cast", card.Name)
+			}
+		}
+
+		item := &StackItem{
+			ID:          ksuid.New().String(),
+			Kind:        "spell",
+			Controller:  username,
+			Description: publicName(card),
+			Targets:     targets,
+			Card:        card,
+		}
+		if description != nil && *description != "" && !isFaceDown(card) {
+			item.Description = *description
+		}
+		pushStack(game, item)
+		if game.Turn != nil && game.Turn.Player == username {
+			cast := 1
+			if game.SpellsCast != nil {
+				cast += *game.SpellsCast
+			}
+			game.SpellsCast = &cast
+		}
+		event = newEvent(gameID, "cast", username, "%s casts %s", username, item.Description)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// ActivateAbility puts an activated or triggered ability on top of the
+// game's stack. sourceID is the instance ID of the permanent it came from,
+// if any. It follows the same priority rules as CastSpell.
+func (s *graphQLServer) ActivateAbility(ctx context.Context, gameID string, username string, description string, sourceID *string, targets []string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		if err := checkPriority(game, username); err != nil {
+			return err
+		}
+		if description == "" {
+			return ErrGame.New("an ability needs a description")
+		}
+		if sourceID != nil {
+			if source, _ := findPermanentInGame(boards, *sourceID); source == nil {
+				return ErrBoardState.New("card %s is not on the battlefield", *sourceID)
+			}
+		}
+
+		pushStack(game, &StackItem{
+			ID:          ksuid.New().String(),
+			Kind:        "ability",
+			Controller:  username,
+			Description: description,
+			Targets:     targets,
+			Source:      sourceID,
+		})
+		event = newEvent(gameID, "ability", username, "%s activates %s", username, description)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// PassPriority passes the player's priority to the next player in turn
+// order. Once every player has passed in succession, the top of the stack
+// resolves and the active player gets priority again. A resolving spell
+// that's a permanent is put onto its controller's battlefield, and any
+// other spell is put into its owner's graveyard.
+func (s *graphQLServer) PassPriority(ctx context.Context, gameID string, username string) (*Game, error) {
+	var events []*GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		if len(game.Stack) == 0 {
+			return ErrGame.New("the stack is empty")
+		}
+		if err := checkPriority(game, username); err != nil {
+			return err
+		}
+
+		game.Passed = append(game.Passed, username)
+		if len(game.Passed) < len(livingPlayers(game)) {
+			next := nextSeat(game, username)
+			game.Priority = &next
+			return nil
+		}
+
+		top := game.Stack[len(game.Stack)-1]
+		game.Stack = game.Stack[:len(game.Stack)-1]
+		game.Passed = nil
+		if err := resolve(top, boards); err != nil {
+			return err
+		}
+		events = append(events, newEvent(gameID, "resolve", top.Controller, "%s resolves", top.Description))
+
+		game.Priority = nil
+		if len(game.Stack) > 0 {
+			active := top.Controller
+			if game.Turn != nil && game.Turn.Player != "" {
+				active = game.Turn.Player
+			}
+			game.Priority = &active
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(events...)
+}
+
+// checkPriority returns an error unless the player can add to the stack or
+// pass priority. Anyone can while the stack is empty.
+func checkPriority(game *Game, username string) error {
+	if game.Priority != nil && *game.Priority != username {
+		return ErrGame.New("%s has priority", *game.Priority)
+	}
+	return nil
+}
+
+// pushStack puts an item on top of the stack and gives its controller
+// priority.
+func pushStack(game *Game, item *StackItem) {
+	controller := item.Controller
+	game.Stack = append(game.Stack, item)
+	game.Priority = &controller
+	game.Passed = nil
+}
+
+// resolve moves a resolving spell's card to where it goes. Abilities have
+// no card, so nothing moves. The card is put on its controller's board, and
+// the state-based actions move it to its owner's graveyard if that's where
+// it belongs.
+func resolve(item *StackItem, boards map[string]*BoardState) error {
+	if item.Card == nil {
+		return nil
+	}
+	bs, ok := boards[item.Controller]
+	if !ok {
+		return ErrBoardState.New("no boardstate for user %s found", item.Controller)
+	}
+	for _, t := range permanentTypes {
+		if hasType(item.Card, t) {
+			putPermanent(bs, item.Card)
+			return nil
+		}
+	}
+	bs.Graveyard = append(bs.Graveyard, item.Card)
+	return nil
+}
+
+// takeCard removes the card with the given instance ID from whichever of
+// the zones it's in, and returns it, or nil.
+func takeCard(zones []*[]*Card, instanceID string) *Card {
+	for _, zone := range zones {
+		for i, c := range *zone {
+			if c.InstanceID != nil && *c.InstanceID == instanceID {
+				*zone = append((*zone)[:i], (*zone)[i+1:]...)
+				return c
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStack(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	swamp := testCard(t, s, "Swamp")
+	vault := testCard(t, s, "Lim-Dûl's Vault")
+	teysa := testCard(t, s, "Teysa Karlov")
+	putBoard(t, s, "alice", &BoardState{Life: 40, Hand: []*Card{angel, swamp}})
+	putBoard(t, s, "bob", &BoardState{Life: 40, Hand: []*Card{vault}, Field: []*Card{teysa}})
+	putBoard(t, s, "carol", &BoardState{Life: 40})
+	s.Directory["game"].Turn = &Turn{Player: "alice", Phase: "main", Number: 1}
+
+	pass := func(t *testing.T, players ...string) *Game {
+		var game *Game
+		for _, p := range players {
+			var err error
+			game, err = s.PassPriority(ctx, "game", p)
+			assert.NoError(t, err, p)
+		}
+		return game
+	}
+
+	t.Run("test casts a spell", func(t *testing.T) {
+		_, err := s.CastSpell(ctx, "game", "alice", *swamp.InstanceID, nil, nil, nil)
+		assert.True(t, ErrBoardState.Has(err))
+		_, err = s.CastSpell(ctx, "game", "alice", *vault.InstanceID, nil, nil, nil)
+		assert.True(t, ErrBoardState.Has(err))
+
+		game, err := s.CastSpell(ctx, "game", "alice", *angel.InstanceID, nil, nil, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, game.Stack, 1) {
+			assert.Equal(t, "spell", game.Stack[0].Kind)
+			assert.Equal(t, "Serra Angel", game.Stack[0].Description)
+			assert.Equal(t, *angel.InstanceID, *game.Stack[0].Card.InstanceID)
+		}
+		assert.Equal(t, "alice", *game.Priority)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Nil(t, findCard(alice.Hand, *angel.InstanceID))
+	})
+
+	t.Run("test needs priority to respond", func(t *testing.T) {
+		_, err := s.CastSpell(ctx, "game", "bob", *vault.InstanceID, nil, nil, nil)
+		assert.True(t, ErrGame.Has(err))
+		_, err = s.PassPriority(ctx, "game", "bob")
+		assert.True(t, ErrGame.Has(err))
+
+		game := pass(t, "alice")
+		assert.Equal(t, "bob", *game.Priority)
+		assert.Equal(t, []string{"alice"}, game.Passed)
+	})
+
+	t.Run("test responds with spells and abilities", func(t *testing.T) {
+		description := "look at the top five cards"
+		game, err := s.CastSpell(ctx, "game", "bob", *vault.InstanceID, &description, []string{"bob"}, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, game.Passed)
+
+		missing := "missing"
+		_, err = s.ActivateAbility(ctx, "game", "bob", "drain", &missing, nil)
+		assert.True(t, ErrBoardState.Has(err))
+		game, err = s.ActivateAbility(ctx, "game", "bob", "drain", teysa.InstanceID, []string{"alice", "carol"})
+		assert.NoError(t, err)
+		if assert.Len(t, game.Stack, 3) {
+			top := game.Stack[2]
+			assert.Equal(t, "ability", top.Kind)
+			assert.Equal(t, *teysa.InstanceID, *top.Source)
+			assert.Equal(t, []string{"alice", "carol"}, top.Targets)
+		}
+		assert.Equal(t, "bob", *game.Priority)
+	})
+
+	t.Run("test resolves top down", func(t *testing.T) {
+		game := pass(t, "bob", "carol", "alice")
+		assert.Len(t, game.Stack, 2)
+		assert.Equal(t, "alice", *game.Priority, "the active player gets priority")
+		assert.Nil(t, game.Passed)
+
+		game = pass(t, "alice", "bob", "carol")
+		assert.Len(t, game.Stack, 1)
+		bob, err := s.boardState("game", "bob")
+		assert.NoError(t, err)
+		assert.NotNil(t, findCard(bob.Graveyard, *vault.InstanceID))
+		assert.Nil(t, findCard(bob.Hand, *vault.InstanceID))
+
+		game = pass(t, "alice", "bob", "carol")
+		assert.Len(t, game.Stack, 0)
+		assert.Nil(t, game.Priority)
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.NotNil(t, findCard(alice.Field, *angel.InstanceID))
+
+		_, err = s.PassPriority(ctx, "game", "alice")
+		assert.True(t, ErrGame.Has(err))
+	})
+
+	t.Run("test logs the stack", func(t *testing.T) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		kinds := []string{}
+		for _, e := range events {
+			kinds = append(kinds, e.Kind)
+		}
+		assert.Equal(t, []string{"cast", "cast", "ability", "resolve", "resolve", "resolve"}, kinds)
+		assert.Equal(t, "Serra Angel resolves", events[5].Text)
+	})
+}
+
+func TestCastFaceDown(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	bushi := testCard(t, s, "Bushi Tenderfoot")
+	putBoard(t, s, "alice", &BoardState{Life: 40, Hand: []*Card{bushi}})
+	putBoard(t, s, "bob", &BoardState{Life: 40})
+	s.gameChannels["game"] = make(chan *Game, 1)
+	faceDown := true
+
+	t.Run("test casts a spell face down", func(t *testing.T) {
+		earlier := *bushi.InstanceID
+		game, err := s.CastSpell(ctx, "game", "alice", earlier, &bushi.Name, nil, &faceDown)
+		assert.NoError(t, err)
+		if !assert.Len(t, game.Stack, 1) {
+			return
+		}
+		assert.Equal(t, "a face-down card", game.Stack[0].Description)
+		assert.Equal(t, bushi.Name, game.Stack[0].Card.Name, "its controller can see it")
+		assert.True(t, isFaceDown(game.Stack[0].Card))
+		assert.NotEqual(t, earlier, *game.Stack[0].Card.InstanceID, "it gets a new instance ID")
+		bushi.InstanceID = game.Stack[0].Card.InstanceID
+
+		published := <-s.gameChannels["game"]
+		games, err := s.Games(ctx, str("game"), str("bob"))
+		assert.NoError(t, err)
+		for _, game := range []*Game{published, games[0]} {
+			if assert.Len(t, game.Stack, 1) {
+				hidden := game.Stack[0].Card
+				assert.Equal(t, "a face-down card", hidden.Name)
+				assert.Equal(t, "", hidden.ID)
+				assert.Nil(t, hidden.Text)
+				assert.Equal(t, "2", *hidden.Power)
+				assert.Equal(t, "Creature", *hidden.Types)
+			}
+		}
+		games, err = s.Games(ctx, str("game"), str("alice"))
+		assert.NoError(t, err)
+		assert.Equal(t, bushi.Name, games[0].Stack[0].Card.Name)
+	})
+
+	t.Run("test resolves face down", func(t *testing.T) {
+		_, err := s.PassPriority(ctx, "game", "alice")
+		assert.NoError(t, err)
+		_, err = s.PassPriority(ctx, "game", "bob")
+		assert.NoError(t, err)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		card := findCard(alice.Field, *bushi.InstanceID)
+		if assert.NotNil(t, card) {
+			assert.True(t, isFaceDown(card))
+			assert.Equal(t, bushi.Name, card.Name)
+		}
+		boards, err := s.Boardstates(ctx, "game", str("alice"), str("bob"))
+		assert.NoError(t, err)
+		assert.Equal(t, "a face-down card", findCard(boards[0].Field, *bushi.InstanceID).Name)
+	})
+
+	t.Run("test never names the spell in the log", func(t *testing.T) {
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		for _, e := range events {
+			assert.NotContains(t, e.Text, "Bushi", e.Text)
+		}
+	})
+}
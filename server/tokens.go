@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"github.com/dylanlott/edh-go/cards"
+)
+
+// CreateToken puts amount tokens onto the player's battlefield. The token
+// is looked up in the card database by its UUID, or by its name, and any
+// other fields given override the token's. When more than one token has the
+// name, the first that matches the fields given is used. A name that isn't
+// in the card database makes a custom token, which needs at least its types.
+func (s *graphQLServer) CreateToken(ctx context.Context, gameID string, username string, token InputToken, amount *int) (*BoardState, error) {
+	n := amountOrOne(amount)
+	if n < 1 {
+		return nil, ErrBoardState.New("can't create %d tokens", n)
+	}
+	def, err := s.tokenDefinition(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateBoardState(gameID, username, func(bs *BoardState) error {
+		for i := 0; i < n; i++ {
+			c := *def
+			c.InstanceID = newInstanceID()
+			c.Owner = &username
+			bs.Field = append(bs.Field, &c)
+		}
+		return nil
+	})
+}
+
+// tokenDefinition returns the Card that CreateToken makes copies of.
+func (s *graphQLServer) tokenDefinition(token InputToken) (*Card, error) {
+	var found []cards.Card
+	if token.UUID != nil && *token.UUID != "" {
+		t, err := s.cards.TokenByUUID(*token.UUID)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		found = append(found, t)
+	} else {
+		var err error
+		if found, err = s.cards.Tokens(token.Name); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+
+	var def *Card
+	for _, t := range found {
+		c := cardFromModel(t)
+		if matchesToken(c, token) {
+			def = c
+			break
+		}
+	}
+	if def == nil && len(found) > 0 {
+		def = cardFromModel(found[0])
+	}
+	if def == nil {
+		if token.Types == nil || *token.Types == "" {
+			return nil, ErrBoardState.New("no token named %s; custom tokens need types", token.Name)
+		}
+		def = &Card{Name: token.Name}
+	}
+
+	// tokens from the card database keep their uuid as their ID, since
+	// they aren't in the cards table
+	if def.UUID != nil {
+		def.ID = *def.UUID
+	}
+	isToken := true
+	def.IsToken = &isToken
+	override(&def.Colors, token.Colors)
+	override(&def.Power, token.Power)
+	override(&def.Toughness, token.Toughness)
+	override(&def.Types, token.Types)
+	override(&def.Subtypes, token.Subtypes)
+	override(&def.Supertypes, token.Supertypes)
+	override(&def.Text, token.Text)
+	return def, nil
+}
+
+// matchesToken returns true if every field given in token matches c.
+func matchesToken(c *Card, token InputToken) bool {
+	fields := []struct{ have, want *string }{
+		{c.Colors, token.Colors},
+		{c.Power, token.Power},
+		{c.Toughness, token.Toughness},
+		{c.Types, token.Types},
+		{c.Subtypes, token.Subtypes},
+	}
+	for _, f := range fields {
+		if f.want == nil {
+			continue
+		}
+		if f.have == nil || !strings.EqualFold(*f.have, *f.want) {
+			return false
+		}
+	}
+	return true
+}
+
+// override sets *field to value if value was given.
+func override(field **string, value *string) {
+	if value != nil {
+		*field = value
+	}
+}
+
+// CopyPermanent puts amount tokens onto the player's battlefield that are
+// copies of a permanent on any player's battlefield in the game. Copies
+// only get the permanent's copiable values: its card details and the face
+// that's showing, but not whether it's tapped, its counters, labels, what
+// it's attached to, its part in combat or whether it's a commander. A copy
+// of a face-down permanent is a face-down 2/2 creature with no name or
+// text, like the permanent looks to players who can't see it. cardID is the
+// permanent's instance ID.
+func (s *graphQLServer) CopyPermanent(ctx context.Context, gameID string, username string, cardID string, amount *int) (*BoardState, error) {
+	n := amountOrOne(amount)
+	if n < 1 {
+		return nil, ErrBoardState.New("can't create %d copies", n)
+	}
+
+	var updated *BoardState
+	err := s.updateBoardStates(gameID, username, func(boards map[string]*BoardState) error {
+		updated = boards[username]
+		if updated == nil {
+			return ErrBoardState.New("no boardstate for user %s found", username)
+		}
+		var original *Card
+		for _, bs := range boards {
+			if original = findPermanent(bs, cardID); original != nil {
+				break
+			}
+		}
+		if original == nil {
+			return ErrBoardState.New("card %s is not on the battlefield", cardID)
+		}
+
+		copiable := original
+		if isFaceDown(original) {
+			copiable = hiddenCreature(original)
+			copiable.Viewers = nil
+		}
+
+		isToken := true
+		for i := 0; i < n; i++ {
+			c := *copiable
+			c.InstanceID = newInstanceID()
+			c.IsToken = &isToken
+			c.Tapped = nil
+			c.Counters = nil
+			c.Labels = nil
+			c.AttachedTo = nil
+			c.Attacking = nil
+			c.Blocking = nil
+			c.Damage = nil
+			c.LethalDamage = nil
+			c.IsCommander = nil
+			c.Owner = &username
+			updated.Field = append(updated.Field, &c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return visibleTo(updated, username), nil
+}
+
+// removeTokens applies the state-based action for tokens: a token that has
+// left the battlefield ceases to exist.
+func removeTokens(boards map[string]*BoardState) {
+	for _, bs := range boards {
+		bs.Commander = withoutTokens(bs.Commander)
+		bs.Library = withoutTokens(bs.Library)
+		bs.Graveyard = withoutTokens(bs.Graveyard)
+		bs.Exiled = withoutTokens(bs.Exiled)
+		bs.Hand = withoutTokens(bs.Hand)
+		bs.Revealed = withoutTokens(bs.Revealed)
+	}
+}
+
+// withoutTokens returns the zone without its tokens.
+func withoutTokens(zone []*Card) []*Card {
+	out := zone[:0]
+	for _, c := range zone {
+		if c.IsToken == nil || !*c.IsToken {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokens(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	angel := testCard(t, s, "Serra Angel")
+	angel.Counters = []*Counter{{Name: "+1/+1", Value: "1"}}
+	putBoard(t, s, "alice", &BoardState{Field: []*Card{angel}})
+	putBoard(t, s, "bob", &BoardState{})
+
+	t.Run("test creates tokens from the card database", func(t *testing.T) {
+		bs, err := s.CreateToken(ctx, "game", "alice", InputToken{Name: "spirit"}, intPtr(2))
+		assert.NoError(t, err)
+		if assert.Len(t, bs.Field, 3) {
+			spirit := bs.Field[1]
+			assert.Equal(t, "Spirit", spirit.Name)
+			assert.Equal(t, "0a0b1c2d-0000-4000-8000-000000000101", spirit.ID)
+			assert.Equal(t, "W", *spirit.Colors)
+			assert.Equal(t, "Flying", *spirit.Text)
+			assert.True(t, *spirit.IsToken)
+			assert.NotEqual(t, *spirit.InstanceID, *bs.Field[2].InstanceID)
+		}
+
+		bs, err = s.CreateToken(ctx, "game", "bob", InputToken{Name: "Treasure", UUID: str("0a0b1c2d-0000-4000-8000-000000000102")}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Artifact", *bs.Field[0].Types)
+	})
+
+	t.Run("test creates custom tokens", func(t *testing.T) {
+		bs, err := s.CreateToken(ctx, "game", "bob", InputToken{
+			Name:      "Spirit",
+			Colors:    str(""),
+			Power:     str("2"),
+			Toughness: str("2"),
+		}, nil)
+		assert.NoError(t, err)
+		spirit := bs.Field[len(bs.Field)-1]
+		assert.Equal(t, "2", *spirit.Power)
+		assert.Equal(t, "", *spirit.Colors)
+		assert.Equal(t, "Creature", *spirit.Types)
+
+		bs, err = s.CreateToken(ctx, "game", "bob", InputToken{
+			Name:  "Elemental",
+			Types: str("Creature"),
+			Power: str("4"),
+		}, nil)
+		assert.NoError(t, err)
+		elemental := bs.Field[len(bs.Field)-1]
+		assert.Equal(t, "", elemental.ID)
+		assert.Equal(t, "4", *elemental.Power)
+
+		_, err = s.CreateToken(ctx, "game", "bob", InputToken{Name: "Elemental"}, nil)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test copies permanents", func(t *testing.T) {
+		_, err := s.updateBoardState("game", "alice", func(bs *BoardState) error {
+			yes, one := true, 1
+			card := findPermanent(bs, *angel.InstanceID)
+			card.IsCommander = &yes
+			card.Attacking = str("bob")
+			card.Damage = &one
+			card.LethalDamage = &yes
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bs, err := s.CopyPermanent(ctx, "game", "bob", *angel.InstanceID, nil)
+		assert.NoError(t, err)
+		copied := bs.Field[len(bs.Field)-1]
+		assert.Nil(t, copied.IsCommander)
+		assert.Nil(t, copied.Attacking)
+		assert.Nil(t, copied.Damage)
+		assert.Nil(t, copied.LethalDamage)
+		assert.Equal(t, "Serra Angel", copied.Name)
+		assert.Equal(t, angel.ID, copied.ID)
+		assert.True(t, *copied.IsToken)
+		assert.Empty(t, copied.Counters)
+		assert.NotEqual(t, *angel.InstanceID, *copied.InstanceID)
+
+		_, err = s.CopyPermanent(ctx, "game", "bob", "missing", nil)
+		assert.True(t, ErrBoardState.Has(err))
+	})
+
+	t.Run("test tokens cease to exist off the battlefield", func(t *testing.T) {
+		bs, err := s.updateBoardState("game", "bob", func(bs *BoardState) error {
+			bs.Graveyard = bs.Field
+			bs.Field = nil
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, bs.Graveyard)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Len(t, alice.Field, 3)
+	})
+}
@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+)
+
+// firstPhase is the phase every turn starts in.
+const firstPhase = "untap"
+
+// seatPlayers gives the players of a new game their seats, in the order
+// they're listed, and picks one of them at random to take the first turn.
+func seatPlayers(game *Game) (*GameEvent, error) {
+	game.Seats = nil
+	for i, u := range game.PlayerIDs {
+		game.Seats = append(game.Seats, &Seat{Position: i, Username: u.Username})
+	}
+	if len(game.Seats) == 0 {
+		return nil, ErrGame.New("a game needs at least one player")
+	}
+
+	first, err := randomInt(len(game.Seats))
+	if err != nil {
+		return nil, err
+	}
+	phase := firstPhase
+	if game.Turn != nil && game.Turn.Phase != "" {
+		phase = game.Turn.Phase
+	}
+	player := game.Seats[first].Username
+	game.Turn = &Turn{Player: player, Phase: phase, Number: 1}
+	return newEvent(game.ID, "turn", player, "%s is randomly chosen to go first", player), nil
+}
+
+// reseatPlayers updates a game's seats after its players change. Players
+// who are still in the game keep their seats and positions, players who
+// joined are seated after everyone else, in the order they're listed, and
+// players who left lose their seats.
+func reseatPlayers(game *Game) {
+	if len(game.Seats) == 0 {
+		// seats come from the players until the game is started
+		return
+	}
+	playing := map[string]bool{}
+	for _, u := range game.PlayerIDs {
+		playing[u.Username] = true
+	}
+
+	seated := map[string]bool{}
+	var kept []*Seat
+	next := 0
+	for _, seat := range game.Seats {
+		if seat.Position >= next {
+			next = seat.Position + 1
+		}
+		if playing[seat.Username] {
+			c := *seat
+			kept = append(kept, &c)
+			seated[seat.Username] = true
+		}
+	}
+	for _, u := range game.PlayerIDs {
+		if seated[u.Username] {
+			continue
+		}
+		kept = append(kept, &Seat{Position: next, Username: u.Username})
+		seated[u.Username] = true
+		next++
+	}
+	game.Seats = kept
+}
+
+// PassTurn ends the active player's turn. The next turn is the most
+// recently added extra turn, if there is one, and otherwise goes to the
+// next player in seat order who hasn't been eliminated. Damage marked on
+// permanents wears off, the designations' turn-based triggers are noted in
+// the event log, and the stack has to be empty.
+func (s *graphQLServer) PassTurn(ctx context.Context, gameID string, username string) (*Game, error) {
+	var events []*GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		if game.Turn == nil || game.Turn.Player != username {
+			return ErrGame.New("it isn't %s's turn", username)
+		}
+		if len(game.Stack) > 0 {
+			return ErrGame.New("the stack isn't empty")
+		}
+		events = advanceTurn(game, boards)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(events...)
+}
+
+// SetPhase moves the active player's turn to another phase or step. Only
+// the active player can.
+func (s *graphQLServer) SetPhase(ctx context.Context, gameID string, username string, phase string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		if game.Turn == nil || game.Turn.Player != username {
+			return ErrGame.New("it isn't %s's turn", username)
+		}
+		if phase == "" {
+			return ErrGame.New("a phase needs a name")
+		}
+		game.Turn.Phase = phase
+		event = newEvent(gameID, "phase", username, "%s moves to %s", username, phase)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// ReverseTurnOrder reverses the direction turns, and priority, pass around
+// the table.
+func (s *graphQLServer) ReverseTurnOrder(ctx context.Context, gameID string, username string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		reversed := !isReversed(game)
+		game.Reversed = &reversed
+		event = newEvent(gameID, "turn", username, "%s reverses the turn order", username)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// TakeExtraTurn gives a player, the one asking if player isn't given, an
+// extra turn after the current one. Extra turns added later are taken
+// first.
+func (s *graphQLServer) TakeExtraTurn(ctx context.Context, gameID string, username string, player *string) (*Game, error) {
+	var event *GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		p := username
+		if player != nil {
+			p = *player
+		}
+		if seat := findSeat(game, p); seat == nil || seat.Eliminated {
+			return ErrGame.New("%s isn't playing in the game", p)
+		}
+		game.ExtraTurns = append([]string{p}, game.ExtraTurns...)
+		event = newEvent(gameID, "turn", p, "%s will take an extra turn", p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(event)
+}
+
+// EliminatePlayer takes a player, the one asking if player isn't given, out
+// of the game. Their seat is skipped from then on. If it was their turn, the
+// turn passes, and if they had priority, it passes too. Their designations
+// leave the game, except that the monarch and the initiative go to the
+// active player.
+func (s *graphQLServer) EliminatePlayer(ctx context.Context, gameID string, username string, player *string) (*Game, error) {
+	var events []*GameEvent
+	game, err := s.updateGameState(gameID, username, func(game *Game, boards map[string]*BoardState) error {
+		p := username
+		if player != nil {
+			p = *player
+		}
+		game.Seats = seats(game)
+		seat := findSeat(game, p)
+		if seat == nil || seat.Eliminated {
+			return ErrGame.New("%s isn't playing in the game", p)
+		}
+		seat.Eliminated = true
+		events = append(events, newEvent(gameID, "eliminated", p, "%s is eliminated", p))
+
+		extra := game.ExtraTurns[:0]
+		for _, u := range game.ExtraTurns {
+			if u != p {
+				extra = append(extra, u)
+			}
+		}
+		game.ExtraTurns = extra
+
+		living := livingPlayers(game)
+		if len(living) == 1 {
+			events = append(events, newEvent(gameID, "winner", living[0], "%s wins the game", living[0]))
+		}
+		if game.Priority != nil && *game.Priority == p {
+			next := nextSeat(game, p)
+			game.Priority = &next
+		}
+		if game.Turn != nil && game.Turn.Player == p && len(living) > 0 {
+			events = append(events, advanceTurn(game, boards)...)
+		}
+
+		// the monarch and the initiative go to the active player
+		kept := game.Designations[:0]
+		var passed []string
+		for _, d := range game.Designations {
+			if d.Player == nil || *d.Player != p {
+				kept = append(kept, d)
+			} else if d.Name == monarch || d.Name == initiative {
+				passed = append(passed, d.Name)
+			}
+		}
+		game.Designations = kept
+		for _, name := range passed {
+			if game.Turn != nil && len(living) > 0 {
+				events = append(events, giveExclusive(game, name, game.Turn.Player)...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, s.logEvents(events...)
+}
+
+// advanceTurn starts the next turn and returns the events announcing it and
+// the turn-based triggers between the turns.
+func advanceTurn(game *Game, boards map[string]*BoardState) []*GameEvent {
+	for _, bs := range boards {
+		for _, c := range battlefield(bs) {
+			c.Damage = nil
+			c.LethalDamage = nil
+			c.Attacking = nil
+			c.Blocking = nil
+		}
+	}
+
+	next := ""
+	for len(game.ExtraTurns) > 0 && next == "" {
+		p := game.ExtraTurns[0]
+		game.ExtraTurns = game.ExtraTurns[1:]
+		if seat := findSeat(game, p); seat != nil && !seat.Eliminated {
+			next = p
+		}
+	}
+	if next == "" {
+		next = nextSeat(game, game.Turn.Player)
+	}
+
+	ending := game.Turn.Player
+	game.Turn = &Turn{Player: next, Phase: firstPhase, Number: game.Turn.Number + 1}
+	game.Priority = nil
+	game.Passed = nil
+	events := []*GameEvent{newEvent(game.ID, "turn", next, "turn %d is %s's", game.Turn.Number, next)}
+	return append(events, turnTriggers(game, ending, next)...)
+}
+
+// seats returns the game's seats in order. Games from before players had
+// seats are seated in the order players joined.
+func seats(game *Game) []*Seat {
+	if len(game.Seats) > 0 {
+		return game.Seats
+	}
+	out := make([]*Seat, 0, len(game.PlayerIDs))
+	for i, u := range game.PlayerIDs {
+		out = append(out, &Seat{Position: i, Username: u.Username})
+	}
+	return out
+}
+
+// findSeat returns the player's seat, or nil.
+func findSeat(game *Game, username string) *Seat {
+	for _, seat := range seats(game) {
+		if seat.Username == username {
+			return seat
+		}
+	}
+	return nil
+}
+
+// livingPlayers returns the usernames of the players who haven't been
+// eliminated, in seat order.
+func livingPlayers(game *Game) []string {
+	var out []string
+	for _, seat := range seats(game) {
+		if !seat.Eliminated {
+			out = append(out, seat.Username)
+		}
+	}
+	return out
+}
+
+// nextSeat returns the player after username in turn order, skipping
+// players who have been eliminated. It's username if nobody else is left.
+func nextSeat(game *Game, username string) string {
+	all := seats(game)
+	n := len(all)
+	if n == 0 {
+		return username
+	}
+	step := 1
+	if isReversed(game) {
+		step = n - 1
+	}
+
+	start := -1
+	for i, seat := range all {
+		if seat.Username == username {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		// someone who isn't seated passes to the first seat
+		start, step = n-1, 1
+	}
+	for i := 1; i <= n; i++ {
+		seat := all[(start+i*step)%n]
+		if !seat.Eliminated {
+			return seat.Username
+		}
+	}
+	return username
+}
+
+func isReversed(game *Game) bool {
+	return game.Reversed != nil && *game.Reversed
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeatPlayers(t *testing.T) {
+	game := &Game{
+		ID:        "game",
+		PlayerIDs: []*User{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}},
+		Turn:      &Turn{Player: "whoever", Number: 7},
+	}
+	event, err := seatPlayers(game)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Seat{
+		{Position: 0, Username: "alice"},
+		{Position: 1, Username: "bob"},
+		{Position: 2, Username: "carol"},
+	}, game.Seats)
+	assert.Contains(t, []string{"alice", "bob", "carol"}, game.Turn.Player)
+	assert.Equal(t, 1, game.Turn.Number)
+	assert.Equal(t, firstPhase, game.Turn.Phase)
+	assert.Equal(t, game.Turn.Player+" is randomly chosen to go first", event.Text)
+
+	_, err = seatPlayers(&Game{ID: "empty"})
+	assert.True(t, ErrGame.Has(err))
+}
+
+func TestReseatPlayers(t *testing.T) {
+	game := &Game{
+		ID:        "game",
+		PlayerIDs: []*User{{Username: "carol"}, {Username: "dave"}, {Username: "alice"}},
+		Seats: []*Seat{
+			{Position: 0, Username: "alice"},
+			{Position: 1, Username: "bob", Eliminated: true},
+			{Position: 2, Username: "carol"},
+		},
+	}
+	reseatPlayers(game)
+	assert.Equal(t, []*Seat{
+		{Position: 0, Username: "alice"},
+		{Position: 2, Username: "carol"},
+		{Position: 3, Username: "dave"},
+	}, game.Seats)
+
+	unseated := &Game{ID: "new", PlayerIDs: []*User{{Username: "alice"}}}
+	reseatPlayers(unseated)
+	assert.Nil(t, unseated.Seats)
+}
+
+func TestUpdateGamePlayers(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	putBoard(t, s, "alice", &BoardState{Life: 40})
+	putBoard(t, s, "bob", &BoardState{Life: 40})
+	_, err := seatPlayers(s.Directory["game"])
+	assert.NoError(t, err)
+	s.Directory["game"].Turn = &Turn{Player: "alice", Phase: "main phase 1", Number: 1}
+
+	game, err := s.UpdateGame(ctx, InputGame{
+		ID:        "game",
+		PlayerIDs: []*InputUser{{Username: "alice"}, {Username: "carol"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Seat{
+		{Position: 0, Username: "alice"},
+		{Position: 2, Username: "carol"},
+	}, game.Seats)
+	assert.Nil(t, findSeat(game, "bob"))
+
+	game, err = s.PassTurn(ctx, "game", "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, "carol", game.Turn.Player, "the new player gets a turn")
+}
+
+func TestTurns(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	damaged := testCard(t, s, "Serra Angel")
+	two := 2
+	damaged.Damage = &two
+	putBoard(t, s, "alice", &BoardState{Life: 40, Field: []*Card{damaged}})
+	putBoard(t, s, "bob", &BoardState{Life: 40})
+	putBoard(t, s, "carol", &BoardState{Life: 40})
+	putBoard(t, s, "dave", &BoardState{Life: 40})
+	s.Directory["game"].Turn = &Turn{Player: "alice", Phase: "main phase 1", Number: 1}
+
+	pass := func(t *testing.T, player string, next string) {
+		game, err := s.PassTurn(ctx, "game", player)
+		assert.NoError(t, err, player)
+		if assert.NotNil(t, game) {
+			assert.Equal(t, next, game.Turn.Player)
+			assert.Equal(t, firstPhase, game.Turn.Phase)
+		}
+	}
+
+	t.Run("test only turn mutations change the turn", func(t *testing.T) {
+		s.gameChannels["game"] = make(chan *Game, 1)
+		game, err := s.UpdateGame(ctx, InputGame{
+			ID:   "game",
+			Turn: &InputTurn{Player: "carol", Phase: "end step", Number: 9},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &Turn{Player: "alice", Phase: "main phase 1", Number: 1}, game.Turn)
+
+		_, err = s.SetPhase(ctx, "game", "bob", "combat")
+		assert.True(t, ErrGame.Has(err))
+		game, err = s.SetPhase(ctx, "game", "alice", "combat")
+		assert.NoError(t, err)
+		assert.Equal(t, "combat", game.Turn.Phase)
+	})
+
+	t.Run("test passes in seat order", func(t *testing.T) {
+		_, err := s.PassTurn(ctx, "game", "bob")
+		assert.True(t, ErrGame.Has(err))
+
+		pass(t, "alice", "bob")
+		assert.Equal(t, 2, s.Directory["game"].Turn.Number)
+
+		alice, err := s.boardState("game", "alice")
+		assert.NoError(t, err)
+		assert.Nil(t, alice.Field[0].Damage, "damage wears off")
+	})
+
+	t.Run("test waits for the stack", func(t *testing.T) {
+		_, err := s.ActivateAbility(ctx, "game", "bob", "draw a card", nil, nil)
+		assert.NoError(t, err)
+		_, err = s.PassTurn(ctx, "game", "bob")
+		assert.True(t, ErrGame.Has(err))
+		for _, p := range []string{"bob", "carol", "dave", "alice"} {
+			_, err := s.PassPriority(ctx, "game", p)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("test skips eliminated players", func(t *testing.T) {
+		game, err := s.EliminatePlayer(ctx, "game", "carol", nil)
+		assert.NoError(t, err)
+		assert.True(t, game.Seats[2].Eliminated)
+		pass(t, "bob", "dave")
+
+		_, err = s.EliminatePlayer(ctx, "game", "bob", str("carol"))
+		assert.True(t, ErrGame.Has(err), "already eliminated")
+	})
+
+	t.Run("test reverses turn order", func(t *testing.T) {
+		game, err := s.ReverseTurnOrder(ctx, "game", "dave")
+		assert.NoError(t, err)
+		assert.True(t, *game.Reversed)
+		pass(t, "dave", "bob")
+	})
+
+	t.Run("test takes extra turns last in first out", func(t *testing.T) {
+		_, err := s.TakeExtraTurn(ctx, "game", "bob", nil)
+		assert.NoError(t, err)
+		game, err := s.TakeExtraTurn(ctx, "game", "bob", str("alice"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob"}, game.ExtraTurns)
+		_, err = s.TakeExtraTurn(ctx, "game", "bob", str("carol"))
+		assert.True(t, ErrGame.Has(err))
+
+		pass(t, "bob", "alice")
+		pass(t, "alice", "bob")
+		pass(t, "bob", "alice")
+	})
+
+	t.Run("test eliminating the active player passes the turn", func(t *testing.T) {
+		game, err := s.EliminatePlayer(ctx, "game", "alice", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "dave", game.Turn.Player)
+
+		_, err = s.EliminatePlayer(ctx, "game", "dave", str("bob"))
+		assert.NoError(t, err)
+		events, err := s.Events(ctx, "game")
+		assert.NoError(t, err)
+		assert.Equal(t, "dave wins the game", events[len(events)-1].Text)
+	})
+}